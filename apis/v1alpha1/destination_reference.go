@@ -0,0 +1,29 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+)
+
+// DestinationReference refers to the ACK resource whose ARN is the
+// destination of a query logging configuration.
+type DestinationReference struct {
+	// The kind of the referenced resource: an S3 Bucket, a CloudWatch Logs
+	// LogGroup or a Kinesis Data Firehose DeliveryStream.
+	// +kubebuilder:validation:Enum=Bucket;LogGroup;DeliveryStream
+	// +kubebuilder:validation:Required
+	Kind *string                           `json:"kind"`
+	From *ackv1alpha1.AWSResourceReference `json:"from,omitempty"`
+}
@@ -66,14 +66,14 @@ const (
 	FirewallDomainImportOperation_REPLACE FirewallDomainImportOperation = "REPLACE"
 )
 
-type FirewallDomainListStatus string
+type FirewallDomainListStatus_SDK string
 
 const (
-	FirewallDomainListStatus_COMPLETE               FirewallDomainListStatus = "COMPLETE"
-	FirewallDomainListStatus_COMPLETE_IMPORT_FAILED FirewallDomainListStatus = "COMPLETE_IMPORT_FAILED"
-	FirewallDomainListStatus_DELETING               FirewallDomainListStatus = "DELETING"
-	FirewallDomainListStatus_IMPORTING              FirewallDomainListStatus = "IMPORTING"
-	FirewallDomainListStatus_UPDATING               FirewallDomainListStatus = "UPDATING"
+	FirewallDomainListStatus_SDK_COMPLETE               FirewallDomainListStatus_SDK = "COMPLETE"
+	FirewallDomainListStatus_SDK_COMPLETE_IMPORT_FAILED FirewallDomainListStatus_SDK = "COMPLETE_IMPORT_FAILED"
+	FirewallDomainListStatus_SDK_DELETING               FirewallDomainListStatus_SDK = "DELETING"
+	FirewallDomainListStatus_SDK_IMPORTING              FirewallDomainListStatus_SDK = "IMPORTING"
+	FirewallDomainListStatus_SDK_UPDATING               FirewallDomainListStatus_SDK = "UPDATING"
 )
 
 type FirewallDomainRedirectionAction string
@@ -99,20 +99,20 @@ const (
 	FirewallFailOpenStatus_USE_LOCAL_RESOURCE_SETTING FirewallFailOpenStatus = "USE_LOCAL_RESOURCE_SETTING"
 )
 
-type FirewallRuleGroupAssociationStatus string
+type FirewallRuleGroupAssociationStatus_SDK string
 
 const (
-	FirewallRuleGroupAssociationStatus_COMPLETE FirewallRuleGroupAssociationStatus = "COMPLETE"
-	FirewallRuleGroupAssociationStatus_DELETING FirewallRuleGroupAssociationStatus = "DELETING"
-	FirewallRuleGroupAssociationStatus_UPDATING FirewallRuleGroupAssociationStatus = "UPDATING"
+	FirewallRuleGroupAssociationStatus_SDK_COMPLETE FirewallRuleGroupAssociationStatus_SDK = "COMPLETE"
+	FirewallRuleGroupAssociationStatus_SDK_DELETING FirewallRuleGroupAssociationStatus_SDK = "DELETING"
+	FirewallRuleGroupAssociationStatus_SDK_UPDATING FirewallRuleGroupAssociationStatus_SDK = "UPDATING"
 )
 
-type FirewallRuleGroupStatus string
+type FirewallRuleGroupStatus_SDK string
 
 const (
-	FirewallRuleGroupStatus_COMPLETE FirewallRuleGroupStatus = "COMPLETE"
-	FirewallRuleGroupStatus_DELETING FirewallRuleGroupStatus = "DELETING"
-	FirewallRuleGroupStatus_UPDATING FirewallRuleGroupStatus = "UPDATING"
+	FirewallRuleGroupStatus_SDK_COMPLETE FirewallRuleGroupStatus_SDK = "COMPLETE"
+	FirewallRuleGroupStatus_SDK_DELETING FirewallRuleGroupStatus_SDK = "DELETING"
+	FirewallRuleGroupStatus_SDK_UPDATING FirewallRuleGroupStatus_SDK = "UPDATING"
 )
 
 type IPAddressStatus string
@@ -139,16 +139,16 @@ const (
 	MutationProtectionStatus_ENABLED  MutationProtectionStatus = "ENABLED"
 )
 
-type OutpostResolverStatus string
+type OutpostResolverStatus_SDK string
 
 const (
-	OutpostResolverStatus_ACTION_NEEDED   OutpostResolverStatus = "ACTION_NEEDED"
-	OutpostResolverStatus_CREATING        OutpostResolverStatus = "CREATING"
-	OutpostResolverStatus_DELETING        OutpostResolverStatus = "DELETING"
-	OutpostResolverStatus_FAILED_CREATION OutpostResolverStatus = "FAILED_CREATION"
-	OutpostResolverStatus_FAILED_DELETION OutpostResolverStatus = "FAILED_DELETION"
-	OutpostResolverStatus_OPERATIONAL     OutpostResolverStatus = "OPERATIONAL"
-	OutpostResolverStatus_UPDATING        OutpostResolverStatus = "UPDATING"
+	OutpostResolverStatus_SDK_ACTION_NEEDED   OutpostResolverStatus_SDK = "ACTION_NEEDED"
+	OutpostResolverStatus_SDK_CREATING        OutpostResolverStatus_SDK = "CREATING"
+	OutpostResolverStatus_SDK_DELETING        OutpostResolverStatus_SDK = "DELETING"
+	OutpostResolverStatus_SDK_FAILED_CREATION OutpostResolverStatus_SDK = "FAILED_CREATION"
+	OutpostResolverStatus_SDK_FAILED_DELETION OutpostResolverStatus_SDK = "FAILED_DELETION"
+	OutpostResolverStatus_SDK_OPERATIONAL     OutpostResolverStatus_SDK = "OPERATIONAL"
+	OutpostResolverStatus_SDK_UPDATING        OutpostResolverStatus_SDK = "UPDATING"
 )
 
 type Protocol string
@@ -30,6 +30,8 @@ import (
 // request, a complex type that contains settings for an existing inbound or
 // outbound Resolver endpoint.
 type ResolverEndpointSpec struct {
+	AdoptionFilters []*Filter `json:"adoptionFilters,omitempty"`
+	AutoRemediation *bool     `json:"autoRemediation,omitempty"`
 
 	// Specify the applicable value:
 	//
@@ -54,6 +56,41 @@ type ResolverEndpointSpec struct {
 	//
 	// Regex Pattern: `^(?!^[0-9]+$)([a-zA-Z0-9\-_' ']+)$`
 	Name *string `json:"name,omitempty"`
+	// The Amazon Resource Name (ARN) of the Outpost. If you specify this, you must
+	// also specify a value for the PreferredInstanceType.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
+	OutpostARN *string `json:"outpostARN,omitempty"`
+	// The instance type. If you specify this, you must also specify a value for
+	// the OutpostArn.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
+	PreferredInstanceType *string `json:"preferredInstanceType,omitempty"`
+	// The protocols you want to use for the endpoint. DoH-FIPS is applicable for
+	// inbound endpoints only.
+	//
+	// For an inbound endpoint you can apply the protocols as follows:
+	//
+	//   - Do53 and DoH in combination.
+	//
+	//   - Do53 and DoH-FIPS in combination.
+	//
+	//   - Do53 alone.
+	//
+	//   - DoH alone.
+	//
+	//   - DoH-FIPS alone.
+	//
+	//   - None, which is treated as Do53.
+	//
+	// For an outbound endpoint you can apply the protocols as follows:
+	//
+	//   - Do53 and DoH in combination.
+	//
+	//   - Do53 alone.
+	//
+	//   - DoH alone.
+	//
+	//   - None, which is treated as Do53.
+	Protocols []*string `json:"protocols,omitempty"`
 	// For the endpoint type you can choose either IPv4, IPv6, or dual-stack. A
 	// dual-stack endpoint means that it will resolve via both IPv4 and IPv6. This
 	// endpoint type is applied to all IP addresses.
@@ -103,6 +140,8 @@ type ResolverEndpointStatus struct {
 	// +kubebuilder:validation:Optional
 	HostVPCID *string `json:"hostVPCID,omitempty"`
 	// +kubebuilder:validation:Optional
+	IPAddressRemediations map[string]*string `json:"ipAddressRemediations,omitempty"`
+	// +kubebuilder:validation:Optional
 	IPAddresses []*IPAddressResponse `json:"ipAddresses,omitempty"`
 	// The ID of the Resolver endpoint.
 	// +kubebuilder:validation:Optional
@@ -114,6 +153,8 @@ type ResolverEndpointStatus struct {
 	// and Coordinated Universal Time (UTC).
 	// +kubebuilder:validation:Optional
 	ModificationTime *string `json:"modificationTime,omitempty"`
+	// +kubebuilder:validation:Optional
+	RemediationSteps []*string `json:"remediationSteps,omitempty"`
 	// A code that specifies the current status of the Resolver endpoint. Valid
 	// values include the following:
 	//
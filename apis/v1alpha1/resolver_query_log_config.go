@@ -28,6 +28,7 @@ import (
 // or ListResolverQueryLogConfigs (https://docs.aws.amazon.com/Route53/latest/APIReference/API_route53resolver_ListResolverQueryLogConfigs.html)
 // request, a complex type that contains settings for one query logging configuration.
 type ResolverQueryLogConfigSpec struct {
+	AdoptionFilters []*Filter `json:"adoptionFilters,omitempty"`
 
 	// The ARN of the resource that you want Resolver to send query logs. You can
 	// send query logs to an S3 bucket, a CloudWatch Logs log group, or a Kinesis
@@ -41,8 +42,9 @@ type ResolverQueryLogConfigSpec struct {
 	//   - Kinesis Data Firehose delivery stream: arn:aws:kinesis:us-east-2:0123456789:stream/my_stream_name
 	//
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
-	// +kubebuilder:validation:Required
-	DestinationARN *string `json:"destinationARN"`
+	DestinationARN *string `json:"destinationARN,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
+	DestinationRef *DestinationReference `json:"destinationRef,omitempty"`
 	// The name that you want to give the query logging configuration.
 	//
 	// Regex Pattern: `^(?!^[0-9]+$)([a-zA-Z0-9\-_' ']+)$`
@@ -31,7 +31,8 @@ import (
 // or UpdateResolverRule (https://docs.aws.amazon.com/Route53/latest/APIReference/API_route53resolver_UpdateResolverRule.html)
 // request.
 type ResolverRuleSpec struct {
-	Associations []*ResolverRuleAssociation_SDK `json:"associations,omitempty"`
+	AdoptionFilters []*Filter                      `json:"adoptionFilters,omitempty"`
+	Associations    []*ResolverRuleAssociation_SDK `json:"associations,omitempty"`
 	// DNS queries for this domain name are forwarded to the IP addresses that you
 	// specify in TargetIps. If a query matches multiple Resolver rules (example.com
 	// and www.example.com), outbound DNS queries are routed using the Resolver
@@ -97,6 +98,8 @@ type ResolverRuleStatus struct {
 	// The ID that Resolver assigned to the Resolver rule when you created it.
 	// +kubebuilder:validation:Optional
 	ID *string `json:"id,omitempty"`
+	// +kubebuilder:validation:Optional
+	LegacyAssociationVPCs []*string `json:"legacyAssociationVPCs,omitempty"`
 	// The date and time that the Resolver rule was last updated, in Unix time format
 	// and Coordinated Universal Time (UTC).
 	// +kubebuilder:validation:Optional
@@ -116,6 +119,8 @@ type ResolverRuleStatus struct {
 	// A detailed description of the status of a Resolver rule.
 	// +kubebuilder:validation:Optional
 	StatusMessage *string `json:"statusMessage,omitempty"`
+	// +kubebuilder:validation:Optional
+	VPCAssociations []*ResolverRuleAssociation_SDK `json:"vpcAssociations,omitempty"`
 }
 
 // ResolverRule is the Schema for the ResolverRules API
@@ -46,24 +46,11 @@ type Filter struct {
 
 // Configuration of the firewall behavior provided by DNS Firewall for a single
 // VPC from Amazon Virtual Private Cloud (Amazon VPC).
-type FirewallConfig struct {
-	ID         *string `json:"id,omitempty"`
-	OwnerID    *string `json:"ownerID,omitempty"`
-	ResourceID *string `json:"resourceID,omitempty"`
-}
-
-// High-level information about a list of firewall domains for use in a FirewallRule.
-// This is returned by GetFirewallDomainList.
-//
-// To retrieve the domains that are defined for this domain list, call ListFirewallDomains.
-type FirewallDomainList struct {
-	ARN              *string `json:"arn,omitempty"`
-	CreationTime     *string `json:"creationTime,omitempty"`
-	CreatorRequestID *string `json:"creatorRequestID,omitempty"`
+type FirewallConfig_SDK struct {
+	FirewallFailOpen *string `json:"firewallFailOpen,omitempty"`
 	ID               *string `json:"id,omitempty"`
-	ModificationTime *string `json:"modificationTime,omitempty"`
-	Name             *string `json:"name,omitempty"`
-	StatusMessage    *string `json:"statusMessage,omitempty"`
+	OwnerID          *string `json:"ownerID,omitempty"`
+	ResourceID       *string `json:"resourceID,omitempty"`
 }
 
 // Minimal high-level information for a firewall domain list. The action ListFirewallDomainLists
@@ -78,42 +65,60 @@ type FirewallDomainListMetadata struct {
 	Name             *string `json:"name,omitempty"`
 }
 
-// A single firewall rule in a rule group.
-type FirewallRule struct {
-	CreationTime               *string `json:"creationTime,omitempty"`
-	CreatorRequestID           *string `json:"creatorRequestID,omitempty"`
-	FirewallDomainListID       *string `json:"firewallDomainListID,omitempty"`
-	FirewallRuleGroupID        *string `json:"firewallRuleGroupID,omitempty"`
-	FirewallThreatProtectionID *string `json:"firewallThreatProtectionID,omitempty"`
-	ModificationTime           *string `json:"modificationTime,omitempty"`
-	Name                       *string `json:"name,omitempty"`
-}
-
-// High-level information for a firewall rule group. A firewall rule group is
-// a collection of rules that DNS Firewall uses to filter DNS network traffic
-// for a VPC. To retrieve the rules for the rule group, call ListFirewallRules.
-type FirewallRuleGroup struct {
+// High-level information about a list of firewall domains for use in a FirewallRule.
+// This is returned by GetFirewallDomainList.
+//
+// To retrieve the domains that are defined for this domain list, call ListFirewallDomains.
+type FirewallDomainList_SDK struct {
 	ARN              *string `json:"arn,omitempty"`
 	CreationTime     *string `json:"creationTime,omitempty"`
 	CreatorRequestID *string `json:"creatorRequestID,omitempty"`
+	DomainCount      *int64  `json:"domainCount,omitempty"`
 	ID               *string `json:"id,omitempty"`
+	ManagedOwnerName *string `json:"managedOwnerName,omitempty"`
 	ModificationTime *string `json:"modificationTime,omitempty"`
 	Name             *string `json:"name,omitempty"`
-	OwnerID          *string `json:"ownerID,omitempty"`
-	ShareStatus      *string `json:"shareStatus,omitempty"`
+	Status           *string `json:"status,omitempty"`
 	StatusMessage    *string `json:"statusMessage,omitempty"`
 }
 
+// A single firewall rule in a rule group.
+type FirewallRule struct {
+	Action               *string `json:"action,omitempty"`
+	BlockOverrideDNSType *string `json:"blockOverrideDNSType,omitempty"`
+	BlockOverrideDomain  *string `json:"blockOverrideDomain,omitempty"`
+	BlockOverrideTTL     *int64  `json:"blockOverrideTTL,omitempty"`
+	BlockResponse        *string `json:"blockResponse,omitempty"`
+	ConfidenceThreshold  *string `json:"confidenceThreshold,omitempty"`
+	CreationTime         *string `json:"creationTime,omitempty"`
+	CreatorRequestID     *string `json:"creatorRequestID,omitempty"`
+	DNSThreatProtection  *string `json:"dnsThreatProtection,omitempty"`
+	FirewallDomainListID *string `json:"firewallDomainListID,omitempty"`
+	// Reference field for FirewallDomainListID
+	FirewallDomainListRef           *ackv1alpha1.AWSResourceReferenceWrapper `json:"firewallDomainListRef,omitempty"`
+	FirewallDomainRedirectionAction *string                                  `json:"firewallDomainRedirectionAction,omitempty"`
+	FirewallRuleGroupID             *string                                  `json:"firewallRuleGroupID,omitempty"`
+	FirewallThreatProtectionID      *string                                  `json:"firewallThreatProtectionID,omitempty"`
+	ModificationTime                *string                                  `json:"modificationTime,omitempty"`
+	Name                            *string                                  `json:"name,omitempty"`
+	Priority                        *int64                                   `json:"priority,omitempty"`
+	Qtype                           *string                                  `json:"qtype,omitempty"`
+}
+
 // An association between a firewall rule group and a VPC, which enables DNS
 // filtering for the VPC.
-type FirewallRuleGroupAssociation struct {
+type FirewallRuleGroupAssociation_SDK struct {
 	ARN                 *string `json:"arn,omitempty"`
 	CreationTime        *string `json:"creationTime,omitempty"`
 	CreatorRequestID    *string `json:"creatorRequestID,omitempty"`
 	FirewallRuleGroupID *string `json:"firewallRuleGroupID,omitempty"`
 	ID                  *string `json:"id,omitempty"`
+	ManagedOwnerName    *string `json:"managedOwnerName,omitempty"`
 	ModificationTime    *string `json:"modificationTime,omitempty"`
+	MutationProtection  *string `json:"mutationProtection,omitempty"`
 	Name                *string `json:"name,omitempty"`
+	Priority            *int64  `json:"priority,omitempty"`
+	Status              *string `json:"status,omitempty"`
 	StatusMessage       *string `json:"statusMessage,omitempty"`
 	VPCID               *string `json:"vpcID,omitempty"`
 }
@@ -132,6 +137,23 @@ type FirewallRuleGroupMetadata struct {
 	ShareStatus      *string `json:"shareStatus,omitempty"`
 }
 
+// High-level information for a firewall rule group. A firewall rule group is
+// a collection of rules that DNS Firewall uses to filter DNS network traffic
+// for a VPC. To retrieve the rules for the rule group, call ListFirewallRules.
+type FirewallRuleGroup_SDK struct {
+	ARN              *string `json:"arn,omitempty"`
+	CreationTime     *string `json:"creationTime,omitempty"`
+	CreatorRequestID *string `json:"creatorRequestID,omitempty"`
+	ID               *string `json:"id,omitempty"`
+	ModificationTime *string `json:"modificationTime,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	OwnerID          *string `json:"ownerID,omitempty"`
+	RuleCount        *int64  `json:"ruleCount,omitempty"`
+	ShareStatus      *string `json:"shareStatus,omitempty"`
+	Status           *string `json:"status,omitempty"`
+	StatusMessage    *string `json:"statusMessage,omitempty"`
+}
+
 // In a CreateResolverEndpoint (https://docs.aws.amazon.com/Route53/latest/APIReference/API_route53resolver_CreateResolverEndpoint.html)
 // request, the IP address that DNS queries originate from (for outbound endpoints)
 // or that you forward DNS queries to (for inbound endpoints). IpAddressRequest
@@ -168,28 +190,36 @@ type IPAddressUpdate struct {
 }
 
 // A complex type that contains settings for an existing Resolver on an Outpost.
-type OutpostResolver struct {
-	ARN              *string `json:"arn,omitempty"`
-	CreationTime     *string `json:"creationTime,omitempty"`
-	CreatorRequestID *string `json:"creatorRequestID,omitempty"`
-	ID               *string `json:"id,omitempty"`
-	ModificationTime *string `json:"modificationTime,omitempty"`
+type OutpostResolver_SDK struct {
+	ARN                   *string `json:"arn,omitempty"`
+	CreationTime          *string `json:"creationTime,omitempty"`
+	CreatorRequestID      *string `json:"creatorRequestID,omitempty"`
+	ID                    *string `json:"id,omitempty"`
+	InstanceCount         *int64  `json:"instanceCount,omitempty"`
+	ModificationTime      *string `json:"modificationTime,omitempty"`
+	Name                  *string `json:"name,omitempty"`
+	OutpostARN            *string `json:"outpostARN,omitempty"`
+	PreferredInstanceType *string `json:"preferredInstanceType,omitempty"`
+	Status                *string `json:"status,omitempty"`
+	StatusMessage         *string `json:"statusMessage,omitempty"`
 }
 
 // A complex type that contains information about a Resolver configuration for
 // a VPC.
-type ResolverConfig struct {
-	ID         *string `json:"id,omitempty"`
-	OwnerID    *string `json:"ownerID,omitempty"`
-	ResourceID *string `json:"resourceID,omitempty"`
+type ResolverConfig_SDK struct {
+	AutodefinedReverse *string `json:"autodefinedReverse,omitempty"`
+	ID                 *string `json:"id,omitempty"`
+	OwnerID            *string `json:"ownerID,omitempty"`
+	ResourceID         *string `json:"resourceID,omitempty"`
 }
 
 // A complex type that contains information about a configuration for DNSSEC
 // validation.
-type ResolverDNSSEcConfig struct {
-	ID         *string `json:"id,omitempty"`
-	OwnerID    *string `json:"ownerID,omitempty"`
-	ResourceID *string `json:"resourceID,omitempty"`
+type ResolverDNSSECConfig_SDK struct {
+	ID               *string `json:"id,omitempty"`
+	OwnerID          *string `json:"ownerID,omitempty"`
+	ResourceID       *string `json:"resourceID,omitempty"`
+	ValidationStatus *string `json:"validationStatus,omitempty"`
 }
 
 // In the response to a CreateResolverEndpoint (https://docs.aws.amazon.com/Route53/latest/APIReference/API_route53resolver_CreateResolverEndpoint.html),
@@ -208,8 +238,11 @@ type ResolverEndpoint_SDK struct {
 	ID                   *string   `json:"id,omitempty"`
 	IPAddressCount       *int64    `json:"ipAddressCount,omitempty"`
 	ModificationTime     *string   `json:"modificationTime,omitempty"`
-	Name                 *string   `json:"name,omitempty"`
-	ResolverEndpointType *string   `json:"resolverEndpointType,omitempty"`
+	Name                  *string   `json:"name,omitempty"`
+	OutpostARN            *string   `json:"outpostARN,omitempty"`
+	PreferredInstanceType *string   `json:"preferredInstanceType,omitempty"`
+	Protocols             []*string `json:"protocols,omitempty"`
+	ResolverEndpointType  *string   `json:"resolverEndpointType,omitempty"`
 	SecurityGroupIDs     []*string `json:"securityGroupIDs,omitempty"`
 	Status               *string   `json:"status,omitempty"`
 	StatusMessage        *string   `json:"statusMessage,omitempty"`
@@ -306,9 +339,11 @@ type Tag struct {
 // In a CreateResolverRule (https://docs.aws.amazon.com/Route53/latest/APIReference/API_route53resolver_CreateResolverRule.html)
 // request, an array of the IPs that you want to forward DNS queries to.
 type TargetAddress struct {
-	IP   *string `json:"ip,omitempty"`
-	IPv6 *string `json:"ipv6,omitempty"`
-	Port *int64  `json:"port,omitempty"`
+	IP                   *string `json:"ip,omitempty"`
+	IPv6                 *string `json:"ipv6,omitempty"`
+	Port                 *int64  `json:"port,omitempty"`
+	Protocol             *string `json:"protocol,omitempty"`
+	ServerNameIndication *string `json:"serverNameIndication,omitempty"`
 }
 
 // Provides information about the IP address type in response to UpdateResolverEndpoint
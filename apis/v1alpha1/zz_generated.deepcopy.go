@@ -24,6 +24,31 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationReference) DeepCopyInto(out *DestinationReference) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(corev1alpha1.AWSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationReference.
+func (in *DestinationReference) DeepCopy() *DestinationReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
@@ -58,48 +83,138 @@ func (in *Filter) DeepCopy() *Filter {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FirewallConfig) DeepCopyInto(out *FirewallConfig) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallConfig.
+func (in *FirewallConfig) DeepCopy() *FirewallConfig {
+	if in == nil {
+		return nil
 	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
+	out := new(FirewallConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallConfigList) DeepCopyInto(out *FirewallConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FirewallConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallConfigList.
+func (in *FirewallConfigList) DeepCopy() *FirewallConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallConfigSpec) DeepCopyInto(out *FirewallConfigSpec) {
+	*out = *in
+	if in.FirewallFailOpen != nil {
+		in, out := &in.FirewallFailOpen, &out.FirewallFailOpen
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResourceID != nil {
-		in, out := &in.ResourceID, &out.ResourceID
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
+	if in.VPCRef != nil {
+		in, out := &in.VPCRef, &out.VPCRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallConfig.
-func (in *FirewallConfig) DeepCopy() *FirewallConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallConfigSpec.
+func (in *FirewallConfigSpec) DeepCopy() *FirewallConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallConfig)
+	out := new(FirewallConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallDomainList) DeepCopyInto(out *FirewallDomainList) {
+func (in *FirewallConfigStatus) DeepCopyInto(out *FirewallConfigStatus) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.CreationTime != nil {
-		in, out := &in.CreationTime, &out.CreationTime
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
 		*out = new(string)
 		**out = **in
 	}
-	if in.CreatorRequestID != nil {
-		in, out := &in.CreatorRequestID, &out.CreatorRequestID
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallConfigStatus.
+func (in *FirewallConfigStatus) DeepCopy() *FirewallConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallConfig_SDK) DeepCopyInto(out *FirewallConfig_SDK) {
+	*out = *in
+	if in.FirewallFailOpen != nil {
+		in, out := &in.FirewallFailOpen, &out.FirewallFailOpen
 		*out = new(string)
 		**out = **in
 	}
@@ -108,21 +223,35 @@ func (in *FirewallDomainList) DeepCopyInto(out *FirewallDomainList) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ModificationTime != nil {
-		in, out := &in.ModificationTime, &out.ModificationTime
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.ResourceID != nil {
+		in, out := &in.ResourceID, &out.ResourceID
 		*out = new(string)
 		**out = **in
 	}
-	if in.StatusMessage != nil {
-		in, out := &in.StatusMessage, &out.StatusMessage
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallConfig_SDK.
+func (in *FirewallConfig_SDK) DeepCopy() *FirewallConfig_SDK {
+	if in == nil {
+		return nil
 	}
+	out := new(FirewallConfig_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallDomainList) DeepCopyInto(out *FirewallDomainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallDomainList.
@@ -135,6 +264,46 @@ func (in *FirewallDomainList) DeepCopy() *FirewallDomainList {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallDomainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallDomainListList) DeepCopyInto(out *FirewallDomainListList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FirewallDomainList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallDomainListList.
+func (in *FirewallDomainListList) DeepCopy() *FirewallDomainListList {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallDomainListList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallDomainListList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FirewallDomainListMetadata) DeepCopyInto(out *FirewallDomainListMetadata) {
 	*out = *in
@@ -171,8 +340,71 @@ func (in *FirewallDomainListMetadata) DeepCopy() *FirewallDomainListMetadata {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
+func (in *FirewallDomainListSpec) DeepCopyInto(out *FirewallDomainListSpec) {
+	*out = *in
+	if in.DomainFileURL != nil {
+		in, out := &in.DomainFileURL, &out.DomainFileURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallDomainListSpec.
+func (in *FirewallDomainListSpec) DeepCopy() *FirewallDomainListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallDomainListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallDomainListStatus) DeepCopyInto(out *FirewallDomainListStatus) {
 	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.CreationTime != nil {
 		in, out := &in.CreationTime, &out.CreationTime
 		*out = new(string)
@@ -183,50 +415,1170 @@ func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.FirewallDomainListID != nil {
-		in, out := &in.FirewallDomainListID, &out.FirewallDomainListID
+	if in.DomainCount != nil {
+		in, out := &in.DomainCount, &out.DomainCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImportedDomainFileURL != nil {
+		in, out := &in.ImportedDomainFileURL, &out.ImportedDomainFileURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedOwnerName != nil {
+		in, out := &in.ManagedOwnerName, &out.ManagedOwnerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ModificationTime != nil {
+		in, out := &in.ModificationTime, &out.ModificationTime
 		*out = new(string)
 		**out = **in
 	}
-	if in.FirewallRuleGroupID != nil {
-		in, out := &in.FirewallRuleGroupID, &out.FirewallRuleGroupID
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallDomainListStatus.
+func (in *FirewallDomainListStatus) DeepCopy() *FirewallDomainListStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallDomainListStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallDomainList_SDK) DeepCopyInto(out *FirewallDomainList_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatorRequestID != nil {
+		in, out := &in.CreatorRequestID, &out.CreatorRequestID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainCount != nil {
+		in, out := &in.DomainCount, &out.DomainCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedOwnerName != nil {
+		in, out := &in.ManagedOwnerName, &out.ManagedOwnerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ModificationTime != nil {
+		in, out := &in.ModificationTime, &out.ModificationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallDomainList_SDK.
+func (in *FirewallDomainList_SDK) DeepCopy() *FirewallDomainList_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallDomainList_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(string)
+		**out = **in
+	}
+	if in.BlockOverrideDNSType != nil {
+		in, out := &in.BlockOverrideDNSType, &out.BlockOverrideDNSType
+		*out = new(string)
+		**out = **in
+	}
+	if in.BlockOverrideDomain != nil {
+		in, out := &in.BlockOverrideDomain, &out.BlockOverrideDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.BlockOverrideTTL != nil {
+		in, out := &in.BlockOverrideTTL, &out.BlockOverrideTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BlockResponse != nil {
+		in, out := &in.BlockResponse, &out.BlockResponse
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfidenceThreshold != nil {
+		in, out := &in.ConfidenceThreshold, &out.ConfidenceThreshold
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatorRequestID != nil {
+		in, out := &in.CreatorRequestID, &out.CreatorRequestID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSThreatProtection != nil {
+		in, out := &in.DNSThreatProtection, &out.DNSThreatProtection
+		*out = new(string)
+		**out = **in
+	}
+	if in.FirewallDomainListID != nil {
+		in, out := &in.FirewallDomainListID, &out.FirewallDomainListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FirewallDomainListRef != nil {
+		in, out := &in.FirewallDomainListRef, &out.FirewallDomainListRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FirewallDomainRedirectionAction != nil {
+		in, out := &in.FirewallDomainRedirectionAction, &out.FirewallDomainRedirectionAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.FirewallRuleGroupID != nil {
+		in, out := &in.FirewallRuleGroupID, &out.FirewallRuleGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FirewallThreatProtectionID != nil {
+		in, out := &in.FirewallThreatProtectionID, &out.FirewallThreatProtectionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ModificationTime != nil {
+		in, out := &in.ModificationTime, &out.ModificationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Qtype != nil {
+		in, out := &in.Qtype, &out.Qtype
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRule.
+func (in *FirewallRule) DeepCopy() *FirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroup) DeepCopyInto(out *FirewallRuleGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroup.
+func (in *FirewallRuleGroup) DeepCopy() *FirewallRuleGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallRuleGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupAssociation) DeepCopyInto(out *FirewallRuleGroupAssociation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupAssociation.
+func (in *FirewallRuleGroupAssociation) DeepCopy() *FirewallRuleGroupAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallRuleGroupAssociation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupAssociationList) DeepCopyInto(out *FirewallRuleGroupAssociationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FirewallRuleGroupAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupAssociationList.
+func (in *FirewallRuleGroupAssociationList) DeepCopy() *FirewallRuleGroupAssociationList {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupAssociationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallRuleGroupAssociationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupAssociationSpec) DeepCopyInto(out *FirewallRuleGroupAssociationSpec) {
+	*out = *in
+	if in.FirewallRuleGroupID != nil {
+		in, out := &in.FirewallRuleGroupID, &out.FirewallRuleGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FirewallRuleGroupRef != nil {
+		in, out := &in.FirewallRuleGroupRef, &out.FirewallRuleGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MutationProtection != nil {
+		in, out := &in.MutationProtection, &out.MutationProtection
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCRef != nil {
+		in, out := &in.VPCRef, &out.VPCRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupAssociationSpec.
+func (in *FirewallRuleGroupAssociationSpec) DeepCopy() *FirewallRuleGroupAssociationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupAssociationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupAssociationStatus) DeepCopyInto(out *FirewallRuleGroupAssociationStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatorRequestID != nil {
+		in, out := &in.CreatorRequestID, &out.CreatorRequestID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedOwnerName != nil {
+		in, out := &in.ManagedOwnerName, &out.ManagedOwnerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ModificationTime != nil {
+		in, out := &in.ModificationTime, &out.ModificationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupAssociationStatus.
+func (in *FirewallRuleGroupAssociationStatus) DeepCopy() *FirewallRuleGroupAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupAssociation_SDK) DeepCopyInto(out *FirewallRuleGroupAssociation_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatorRequestID != nil {
+		in, out := &in.CreatorRequestID, &out.CreatorRequestID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FirewallRuleGroupID != nil {
+		in, out := &in.FirewallRuleGroupID, &out.FirewallRuleGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedOwnerName != nil {
+		in, out := &in.ManagedOwnerName, &out.ManagedOwnerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ModificationTime != nil {
+		in, out := &in.ModificationTime, &out.ModificationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.MutationProtection != nil {
+		in, out := &in.MutationProtection, &out.MutationProtection
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupAssociation_SDK.
+func (in *FirewallRuleGroupAssociation_SDK) DeepCopy() *FirewallRuleGroupAssociation_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupAssociation_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupList) DeepCopyInto(out *FirewallRuleGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FirewallRuleGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupList.
+func (in *FirewallRuleGroupList) DeepCopy() *FirewallRuleGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallRuleGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupMetadata) DeepCopyInto(out *FirewallRuleGroupMetadata) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatorRequestID != nil {
+		in, out := &in.CreatorRequestID, &out.CreatorRequestID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShareStatus != nil {
+		in, out := &in.ShareStatus, &out.ShareStatus
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupMetadata.
+func (in *FirewallRuleGroupMetadata) DeepCopy() *FirewallRuleGroupMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupPolicy) DeepCopyInto(out *FirewallRuleGroupPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupPolicy.
+func (in *FirewallRuleGroupPolicy) DeepCopy() *FirewallRuleGroupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallRuleGroupPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupPolicyList) DeepCopyInto(out *FirewallRuleGroupPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FirewallRuleGroupPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupPolicyList.
+func (in *FirewallRuleGroupPolicyList) DeepCopy() *FirewallRuleGroupPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallRuleGroupPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupPolicySpec) DeepCopyInto(out *FirewallRuleGroupPolicySpec) {
+	*out = *in
+	if in.FirewallRuleGroupARN != nil {
+		in, out := &in.FirewallRuleGroupARN, &out.FirewallRuleGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.FirewallRuleGroupRef != nil {
+		in, out := &in.FirewallRuleGroupRef, &out.FirewallRuleGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupPolicySpec.
+func (in *FirewallRuleGroupPolicySpec) DeepCopy() *FirewallRuleGroupPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupPolicyStatus) DeepCopyInto(out *FirewallRuleGroupPolicyStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.FirewallRuleGroupPolicy != nil {
+		in, out := &in.FirewallRuleGroupPolicy, &out.FirewallRuleGroupPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShareStatus != nil {
+		in, out := &in.ShareStatus, &out.ShareStatus
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupPolicyStatus.
+func (in *FirewallRuleGroupPolicyStatus) DeepCopy() *FirewallRuleGroupPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupSpec) DeepCopyInto(out *FirewallRuleGroupSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]*FirewallRule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(FirewallRule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupSpec.
+func (in *FirewallRuleGroupSpec) DeepCopy() *FirewallRuleGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroupStatus) DeepCopyInto(out *FirewallRuleGroupStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatorRequestID != nil {
+		in, out := &in.CreatorRequestID, &out.CreatorRequestID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ModificationTime != nil {
+		in, out := &in.ModificationTime, &out.ModificationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RuleCount != nil {
+		in, out := &in.RuleCount, &out.RuleCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ShareStatus != nil {
+		in, out := &in.ShareStatus, &out.ShareStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupStatus.
+func (in *FirewallRuleGroupStatus) DeepCopy() *FirewallRuleGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleGroup_SDK) DeepCopyInto(out *FirewallRuleGroup_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatorRequestID != nil {
+		in, out := &in.CreatorRequestID, &out.CreatorRequestID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ModificationTime != nil {
+		in, out := &in.ModificationTime, &out.ModificationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RuleCount != nil {
+		in, out := &in.RuleCount, &out.RuleCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ShareStatus != nil {
+		in, out := &in.ShareStatus, &out.ShareStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroup_SDK.
+func (in *FirewallRuleGroup_SDK) DeepCopy() *FirewallRuleGroup_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleGroup_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressRequest) DeepCopyInto(out *IPAddressRequest) {
+	*out = *in
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetRef != nil {
+		in, out := &in.SubnetRef, &out.SubnetRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressRequest.
+func (in *IPAddressRequest) DeepCopy() *IPAddressRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressResponse) DeepCopyInto(out *IPAddressResponse) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPID != nil {
+		in, out := &in.IPID, &out.IPID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(string)
+		**out = **in
+	}
+	if in.ModificationTime != nil {
+		in, out := &in.ModificationTime, &out.ModificationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressResponse.
+func (in *IPAddressResponse) DeepCopy() *IPAddressResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressUpdate) DeepCopyInto(out *IPAddressUpdate) {
+	*out = *in
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPID != nil {
+		in, out := &in.IPID, &out.IPID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressUpdate.
+func (in *IPAddressUpdate) DeepCopy() *IPAddressUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutpostResolver) DeepCopyInto(out *OutpostResolver) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutpostResolver.
+func (in *OutpostResolver) DeepCopy() *OutpostResolver {
+	if in == nil {
+		return nil
+	}
+	out := new(OutpostResolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OutpostResolver) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutpostResolverList) DeepCopyInto(out *OutpostResolverList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OutpostResolver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutpostResolverList.
+func (in *OutpostResolverList) DeepCopy() *OutpostResolverList {
+	if in == nil {
+		return nil
+	}
+	out := new(OutpostResolverList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OutpostResolverList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutpostResolverSpec) DeepCopyInto(out *OutpostResolverSpec) {
+	*out = *in
+	if in.InstanceCount != nil {
+		in, out := &in.InstanceCount, &out.InstanceCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.FirewallThreatProtectionID != nil {
-		in, out := &in.FirewallThreatProtectionID, &out.FirewallThreatProtectionID
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.ModificationTime != nil {
-		in, out := &in.ModificationTime, &out.ModificationTime
+	if in.PreferredInstanceType != nil {
+		in, out := &in.PreferredInstanceType, &out.PreferredInstanceType
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRule.
-func (in *FirewallRule) DeepCopy() *FirewallRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutpostResolverSpec.
+func (in *OutpostResolverSpec) DeepCopy() *OutpostResolverSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallRule)
+	out := new(OutpostResolverSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallRuleGroup) DeepCopyInto(out *FirewallRuleGroup) {
+func (in *OutpostResolverStatus) DeepCopyInto(out *OutpostResolverStatus) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 	if in.CreationTime != nil {
 		in, out := &in.CreationTime, &out.CreationTime
@@ -248,18 +1600,8 @@ func (in *FirewallRuleGroup) DeepCopyInto(out *FirewallRuleGroup) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ShareStatus != nil {
-		in, out := &in.ShareStatus, &out.ShareStatus
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
@@ -270,18 +1612,18 @@ func (in *FirewallRuleGroup) DeepCopyInto(out *FirewallRuleGroup) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroup.
-func (in *FirewallRuleGroup) DeepCopy() *FirewallRuleGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutpostResolverStatus.
+func (in *OutpostResolverStatus) DeepCopy() *OutpostResolverStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallRuleGroup)
+	out := new(OutpostResolverStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallRuleGroupAssociation) DeepCopyInto(out *FirewallRuleGroupAssociation) {
+func (in *OutpostResolver_SDK) DeepCopyInto(out *OutpostResolver_SDK) {
 	*out = *in
 	if in.ARN != nil {
 		in, out := &in.ARN, &out.ARN
@@ -298,16 +1640,16 @@ func (in *FirewallRuleGroupAssociation) DeepCopyInto(out *FirewallRuleGroupAssoc
 		*out = new(string)
 		**out = **in
 	}
-	if in.FirewallRuleGroupID != nil {
-		in, out := &in.FirewallRuleGroupID, &out.FirewallRuleGroupID
-		*out = new(string)
-		**out = **in
-	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
+	if in.InstanceCount != nil {
+		in, out := &in.InstanceCount, &out.InstanceCount
+		*out = new(int64)
+		**out = **in
+	}
 	if in.ModificationTime != nil {
 		in, out := &in.ModificationTime, &out.ModificationTime
 		*out = new(string)
@@ -318,241 +1660,316 @@ func (in *FirewallRuleGroupAssociation) DeepCopyInto(out *FirewallRuleGroupAssoc
 		*out = new(string)
 		**out = **in
 	}
-	if in.StatusMessage != nil {
-		in, out := &in.StatusMessage, &out.StatusMessage
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
+	if in.PreferredInstanceType != nil {
+		in, out := &in.PreferredInstanceType, &out.PreferredInstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupAssociation.
-func (in *FirewallRuleGroupAssociation) DeepCopy() *FirewallRuleGroupAssociation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutpostResolver_SDK.
+func (in *OutpostResolver_SDK) DeepCopy() *OutpostResolver_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallRuleGroupAssociation)
+	out := new(OutpostResolver_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallRuleGroupMetadata) DeepCopyInto(out *FirewallRuleGroupMetadata) {
+func (in *ResolverConfig) DeepCopyInto(out *ResolverConfig) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.CreatorRequestID != nil {
-		in, out := &in.CreatorRequestID, &out.CreatorRequestID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverConfig.
+func (in *ResolverConfig) DeepCopy() *ResolverConfig {
+	if in == nil {
+		return nil
 	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
+	out := new(ResolverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResolverConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.ShareStatus != nil {
-		in, out := &in.ShareStatus, &out.ShareStatus
-		*out = new(string)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverConfigList) DeepCopyInto(out *ResolverConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResolverConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleGroupMetadata.
-func (in *FirewallRuleGroupMetadata) DeepCopy() *FirewallRuleGroupMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverConfigList.
+func (in *ResolverConfigList) DeepCopy() *ResolverConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallRuleGroupMetadata)
+	out := new(ResolverConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResolverConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAddressRequest) DeepCopyInto(out *IPAddressRequest) {
+func (in *ResolverConfigSpec) DeepCopyInto(out *ResolverConfigSpec) {
 	*out = *in
-	if in.IP != nil {
-		in, out := &in.IP, &out.IP
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6 != nil {
-		in, out := &in.IPv6, &out.IPv6
+	if in.AutodefinedReverseFlag != nil {
+		in, out := &in.AutodefinedReverseFlag, &out.AutodefinedReverseFlag
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetRef != nil {
-		in, out := &in.SubnetRef, &out.SubnetRef
+	if in.VPCRef != nil {
+		in, out := &in.VPCRef, &out.VPCRef
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressRequest.
-func (in *IPAddressRequest) DeepCopy() *IPAddressRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverConfigSpec.
+func (in *ResolverConfigSpec) DeepCopy() *ResolverConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAddressRequest)
+	out := new(ResolverConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAddressResponse) DeepCopyInto(out *IPAddressResponse) {
+func (in *ResolverConfigStatus) DeepCopyInto(out *ResolverConfigStatus) {
 	*out = *in
-	if in.CreationTime != nil {
-		in, out := &in.CreationTime, &out.CreationTime
-		*out = new(string)
-		**out = **in
-	}
-	if in.IP != nil {
-		in, out := &in.IP, &out.IP
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPID != nil {
-		in, out := &in.IPID, &out.IPID
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6 != nil {
-		in, out := &in.IPv6, &out.IPv6
-		*out = new(string)
-		**out = **in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ModificationTime != nil {
-		in, out := &in.ModificationTime, &out.ModificationTime
-		*out = new(string)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
+	if in.AutodefinedReverse != nil {
+		in, out := &in.AutodefinedReverse, &out.AutodefinedReverse
 		*out = new(string)
 		**out = **in
 	}
-	if in.StatusMessage != nil {
-		in, out := &in.StatusMessage, &out.StatusMessage
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressResponse.
-func (in *IPAddressResponse) DeepCopy() *IPAddressResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverConfigStatus.
+func (in *ResolverConfigStatus) DeepCopy() *ResolverConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAddressResponse)
+	out := new(ResolverConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAddressUpdate) DeepCopyInto(out *IPAddressUpdate) {
+func (in *ResolverConfig_SDK) DeepCopyInto(out *ResolverConfig_SDK) {
 	*out = *in
-	if in.IP != nil {
-		in, out := &in.IP, &out.IP
+	if in.AutodefinedReverse != nil {
+		in, out := &in.AutodefinedReverse, &out.AutodefinedReverse
 		*out = new(string)
 		**out = **in
 	}
-	if in.IPID != nil {
-		in, out := &in.IPID, &out.IPID
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.IPv6 != nil {
-		in, out := &in.IPv6, &out.IPv6
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
+	if in.ResourceID != nil {
+		in, out := &in.ResourceID, &out.ResourceID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressUpdate.
-func (in *IPAddressUpdate) DeepCopy() *IPAddressUpdate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverConfig_SDK.
+func (in *ResolverConfig_SDK) DeepCopy() *ResolverConfig_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolverConfig_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverDNSSECConfig) DeepCopyInto(out *ResolverDNSSECConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverDNSSECConfig.
+func (in *ResolverDNSSECConfig) DeepCopy() *ResolverDNSSECConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAddressUpdate)
+	out := new(ResolverDNSSECConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResolverDNSSECConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OutpostResolver) DeepCopyInto(out *OutpostResolver) {
+func (in *ResolverDNSSECConfigList) DeepCopyInto(out *ResolverDNSSECConfigList) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResolverDNSSECConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.CreationTime != nil {
-		in, out := &in.CreationTime, &out.CreationTime
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverDNSSECConfigList.
+func (in *ResolverDNSSECConfigList) DeepCopy() *ResolverDNSSECConfigList {
+	if in == nil {
+		return nil
 	}
-	if in.CreatorRequestID != nil {
-		in, out := &in.CreatorRequestID, &out.CreatorRequestID
-		*out = new(string)
-		**out = **in
+	out := new(ResolverDNSSECConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResolverDNSSECConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverDNSSECConfigSpec) DeepCopyInto(out *ResolverDNSSECConfigSpec) {
+	*out = *in
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
 		*out = new(string)
 		**out = **in
 	}
-	if in.ModificationTime != nil {
-		in, out := &in.ModificationTime, &out.ModificationTime
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
+	if in.VPCRef != nil {
+		in, out := &in.VPCRef, &out.VPCRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutpostResolver.
-func (in *OutpostResolver) DeepCopy() *OutpostResolver {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverDNSSECConfigSpec.
+func (in *ResolverDNSSECConfigSpec) DeepCopy() *ResolverDNSSECConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OutpostResolver)
+	out := new(ResolverDNSSECConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResolverConfig) DeepCopyInto(out *ResolverConfig) {
+func (in *ResolverDNSSECConfigStatus) DeepCopyInto(out *ResolverDNSSECConfigStatus) {
 	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -563,25 +1980,25 @@ func (in *ResolverConfig) DeepCopyInto(out *ResolverConfig) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ResourceID != nil {
-		in, out := &in.ResourceID, &out.ResourceID
+	if in.ValidationStatus != nil {
+		in, out := &in.ValidationStatus, &out.ValidationStatus
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverConfig.
-func (in *ResolverConfig) DeepCopy() *ResolverConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverDNSSECConfigStatus.
+func (in *ResolverDNSSECConfigStatus) DeepCopy() *ResolverDNSSECConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResolverConfig)
+	out := new(ResolverDNSSECConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResolverDNSSEcConfig) DeepCopyInto(out *ResolverDNSSEcConfig) {
+func (in *ResolverDNSSECConfig_SDK) DeepCopyInto(out *ResolverDNSSECConfig_SDK) {
 	*out = *in
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
@@ -598,14 +2015,19 @@ func (in *ResolverDNSSEcConfig) DeepCopyInto(out *ResolverDNSSEcConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ValidationStatus != nil {
+		in, out := &in.ValidationStatus, &out.ValidationStatus
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverDNSSEcConfig.
-func (in *ResolverDNSSEcConfig) DeepCopy() *ResolverDNSSEcConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverDNSSECConfig_SDK.
+func (in *ResolverDNSSECConfig_SDK) DeepCopy() *ResolverDNSSECConfig_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(ResolverDNSSEcConfig)
+	out := new(ResolverDNSSECConfig_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -672,6 +2094,22 @@ func (in *ResolverEndpointList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolverEndpointSpec) DeepCopyInto(out *ResolverEndpointSpec) {
 	*out = *in
+	if in.AdoptionFilters != nil {
+		in, out := &in.AdoptionFilters, &out.AdoptionFilters
+		*out = make([]*Filter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Filter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AutoRemediation != nil {
+		in, out := &in.AutoRemediation, &out.AutoRemediation
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Direction != nil {
 		in, out := &in.Direction, &out.Direction
 		*out = new(string)
@@ -693,6 +2131,27 @@ func (in *ResolverEndpointSpec) DeepCopyInto(out *ResolverEndpointSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredInstanceType != nil {
+		in, out := &in.PreferredInstanceType, &out.PreferredInstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.ResolverEndpointType != nil {
 		in, out := &in.ResolverEndpointType, &out.ResolverEndpointType
 		*out = new(string)
@@ -777,6 +2236,22 @@ func (in *ResolverEndpointStatus) DeepCopyInto(out *ResolverEndpointStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPAddressRemediations != nil {
+		in, out := &in.IPAddressRemediations, &out.IPAddressRemediations
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.IPAddresses != nil {
 		in, out := &in.IPAddresses, &out.IPAddresses
 		*out = make([]*IPAddressResponse, len(*in))
@@ -803,6 +2278,17 @@ func (in *ResolverEndpointStatus) DeepCopyInto(out *ResolverEndpointStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RemediationSteps != nil {
+		in, out := &in.RemediationSteps, &out.RemediationSteps
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -873,6 +2359,27 @@ func (in *ResolverEndpoint_SDK) DeepCopyInto(out *ResolverEndpoint_SDK) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredInstanceType != nil {
+		in, out := &in.PreferredInstanceType, &out.PreferredInstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.ResolverEndpointType != nil {
 		in, out := &in.ResolverEndpointType, &out.ResolverEndpointType
 		*out = new(string)
@@ -1078,106 +2585,253 @@ func (in *ResolverQueryLogConfigAssociationStatus) DeepCopyInto(out *ResolverQue
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverQueryLogConfigAssociationStatus.
-func (in *ResolverQueryLogConfigAssociationStatus) DeepCopy() *ResolverQueryLogConfigAssociationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverQueryLogConfigAssociationStatus.
+func (in *ResolverQueryLogConfigAssociationStatus) DeepCopy() *ResolverQueryLogConfigAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolverQueryLogConfigAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverQueryLogConfigAssociation_SDK) DeepCopyInto(out *ResolverQueryLogConfigAssociation_SDK) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorMessage != nil {
+		in, out := &in.ErrorMessage, &out.ErrorMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolverQueryLogConfigID != nil {
+		in, out := &in.ResolverQueryLogConfigID, &out.ResolverQueryLogConfigID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceID != nil {
+		in, out := &in.ResourceID, &out.ResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverQueryLogConfigAssociation_SDK.
+func (in *ResolverQueryLogConfigAssociation_SDK) DeepCopy() *ResolverQueryLogConfigAssociation_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolverQueryLogConfigAssociation_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverQueryLogConfigList) DeepCopyInto(out *ResolverQueryLogConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResolverQueryLogConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverQueryLogConfigList.
+func (in *ResolverQueryLogConfigList) DeepCopy() *ResolverQueryLogConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolverQueryLogConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResolverQueryLogConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverQueryLogConfigPolicy) DeepCopyInto(out *ResolverQueryLogConfigPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverQueryLogConfigPolicy.
+func (in *ResolverQueryLogConfigPolicy) DeepCopy() *ResolverQueryLogConfigPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolverQueryLogConfigPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResolverQueryLogConfigPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverQueryLogConfigPolicyList) DeepCopyInto(out *ResolverQueryLogConfigPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResolverQueryLogConfigPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverQueryLogConfigPolicyList.
+func (in *ResolverQueryLogConfigPolicyList) DeepCopy() *ResolverQueryLogConfigPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ResolverQueryLogConfigAssociationStatus)
+	out := new(ResolverQueryLogConfigPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResolverQueryLogConfigPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResolverQueryLogConfigAssociation_SDK) DeepCopyInto(out *ResolverQueryLogConfigAssociation_SDK) {
+func (in *ResolverQueryLogConfigPolicySpec) DeepCopyInto(out *ResolverQueryLogConfigPolicySpec) {
 	*out = *in
-	if in.CreationTime != nil {
-		in, out := &in.CreationTime, &out.CreationTime
-		*out = new(string)
-		**out = **in
-	}
-	if in.Error != nil {
-		in, out := &in.Error, &out.Error
-		*out = new(string)
-		**out = **in
-	}
-	if in.ErrorMessage != nil {
-		in, out := &in.ErrorMessage, &out.ErrorMessage
-		*out = new(string)
-		**out = **in
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ResolverQueryLogConfigID != nil {
-		in, out := &in.ResolverQueryLogConfigID, &out.ResolverQueryLogConfigID
-		*out = new(string)
-		**out = **in
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
-	if in.ResourceID != nil {
-		in, out := &in.ResourceID, &out.ResourceID
+	if in.ResolverQueryLogConfigARN != nil {
+		in, out := &in.ResolverQueryLogConfigARN, &out.ResolverQueryLogConfigARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
+	if in.ResolverQueryLogConfigRef != nil {
+		in, out := &in.ResolverQueryLogConfigRef, &out.ResolverQueryLogConfigRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverQueryLogConfigAssociation_SDK.
-func (in *ResolverQueryLogConfigAssociation_SDK) DeepCopy() *ResolverQueryLogConfigAssociation_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverQueryLogConfigPolicySpec.
+func (in *ResolverQueryLogConfigPolicySpec) DeepCopy() *ResolverQueryLogConfigPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ResolverQueryLogConfigAssociation_SDK)
+	out := new(ResolverQueryLogConfigPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResolverQueryLogConfigList) DeepCopyInto(out *ResolverQueryLogConfigList) {
+func (in *ResolverQueryLogConfigPolicyStatus) DeepCopyInto(out *ResolverQueryLogConfigPolicyStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ResolverQueryLogConfig, len(*in))
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
 		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
 		}
 	}
+	if in.ResolverQueryLogConfigPolicy != nil {
+		in, out := &in.ResolverQueryLogConfigPolicy, &out.ResolverQueryLogConfigPolicy
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverQueryLogConfigList.
-func (in *ResolverQueryLogConfigList) DeepCopy() *ResolverQueryLogConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverQueryLogConfigPolicyStatus.
+func (in *ResolverQueryLogConfigPolicyStatus) DeepCopy() *ResolverQueryLogConfigPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResolverQueryLogConfigList)
+	out := new(ResolverQueryLogConfigPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ResolverQueryLogConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolverQueryLogConfigSpec) DeepCopyInto(out *ResolverQueryLogConfigSpec) {
 	*out = *in
+	if in.AdoptionFilters != nil {
+		in, out := &in.AdoptionFilters, &out.AdoptionFilters
+		*out = make([]*Filter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Filter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.DestinationARN != nil {
 		in, out := &in.DestinationARN, &out.DestinationARN
 		*out = new(string)
 		**out = **in
 	}
+	if in.DestinationRef != nil {
+		in, out := &in.DestinationRef, &out.DestinationRef
+		*out = new(DestinationReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -1612,9 +3266,162 @@ func (in *ResolverRuleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverRulePolicy) DeepCopyInto(out *ResolverRulePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverRulePolicy.
+func (in *ResolverRulePolicy) DeepCopy() *ResolverRulePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolverRulePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResolverRulePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverRulePolicyList) DeepCopyInto(out *ResolverRulePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResolverRulePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverRulePolicyList.
+func (in *ResolverRulePolicyList) DeepCopy() *ResolverRulePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolverRulePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResolverRulePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverRulePolicySpec) DeepCopyInto(out *ResolverRulePolicySpec) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ResolverRuleARN != nil {
+		in, out := &in.ResolverRuleARN, &out.ResolverRuleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolverRuleRef != nil {
+		in, out := &in.ResolverRuleRef, &out.ResolverRuleRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverRulePolicySpec.
+func (in *ResolverRulePolicySpec) DeepCopy() *ResolverRulePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolverRulePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolverRulePolicyStatus) DeepCopyInto(out *ResolverRulePolicyStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ResolverRulePolicy != nil {
+		in, out := &in.ResolverRulePolicy, &out.ResolverRulePolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverRulePolicyStatus.
+func (in *ResolverRulePolicyStatus) DeepCopy() *ResolverRulePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolverRulePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolverRuleSpec) DeepCopyInto(out *ResolverRuleSpec) {
 	*out = *in
+	if in.AdoptionFilters != nil {
+		in, out := &in.AdoptionFilters, &out.AdoptionFilters
+		*out = make([]*Filter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Filter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Associations != nil {
 		in, out := &in.Associations, &out.Associations
 		*out = make([]*ResolverRuleAssociation_SDK, len(*in))
@@ -1719,6 +3526,17 @@ func (in *ResolverRuleStatus) DeepCopyInto(out *ResolverRuleStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LegacyAssociationVPCs != nil {
+		in, out := &in.LegacyAssociationVPCs, &out.LegacyAssociationVPCs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.ModificationTime != nil {
 		in, out := &in.ModificationTime, &out.ModificationTime
 		*out = new(string)
@@ -1744,6 +3562,17 @@ func (in *ResolverRuleStatus) DeepCopyInto(out *ResolverRuleStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.VPCAssociations != nil {
+		in, out := &in.VPCAssociations, &out.VPCAssociations
+		*out = make([]*ResolverRuleAssociation_SDK, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ResolverRuleAssociation_SDK)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolverRuleStatus.
@@ -1890,6 +3719,16 @@ func (in *TargetAddress) DeepCopyInto(out *TargetAddress) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerNameIndication != nil {
+		in, out := &in.ServerNameIndication, &out.ServerNameIndication
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetAddress.
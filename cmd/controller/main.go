@@ -42,11 +42,21 @@ import (
 	svctypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
 	svcresource "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource"
 
+	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/firewall_config"
+	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/firewall_domain_list"
+	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/firewall_rule_group"
+	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/firewall_rule_group_association"
+	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/firewall_rule_group_policy"
+	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/outpost_resolver"
+	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/resolver_config"
+	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/resolver_dnssec_config"
 	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/resolver_endpoint"
 	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/resolver_query_log_config"
 	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/resolver_query_log_config_association"
+	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/resolver_query_log_config_policy"
 	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/resolver_rule"
 	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/resolver_rule_association"
+	_ "github.com/aws-controllers-k8s/route53resolver-controller/pkg/resource/resolver_rule_policy"
 
 	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/version"
 )
@@ -69,6 +69,14 @@ rules:
   - get
   - list
   - watch
+- apiGroups:
+  - cloudwatchlogs.services.k8s.aws
+  resources:
+  - loggroups
+  - loggroups/status
+  verbs:
+  - get
+  - list
 - apiGroups:
   - ec2.services.k8s.aws
   resources:
@@ -81,13 +89,31 @@ rules:
   verbs:
   - get
   - list
+- apiGroups:
+  - firehose.services.k8s.aws
+  resources:
+  - deliverystreams
+  - deliverystreams/status
+  verbs:
+  - get
+  - list
 - apiGroups:
   - route53resolver.services.k8s.aws
   resources:
+  - firewallconfigs
+  - firewalldomainlists
+  - firewallrulegroupassociations
+  - firewallrulegrouppolicies
+  - firewallrulegroups
+  - outpostresolvers
+  - resolverconfigs
+  - resolverdnssecconfigs
   - resolverendpoints
   - resolverquerylogconfigassociations
+  - resolverquerylogconfigpolicies
   - resolverquerylogconfigs
   - resolverruleassociations
+  - resolverrulepolicies
   - resolverrules
   verbs:
   - create
@@ -100,15 +126,33 @@ rules:
 - apiGroups:
   - route53resolver.services.k8s.aws
   resources:
+  - firewallconfigs/status
+  - firewalldomainlists/status
+  - firewallrulegroupassociations/status
+  - firewallrulegrouppolicies/status
+  - firewallrulegroups/status
+  - outpostresolvers/status
+  - resolverconfigs/status
+  - resolverdnssecconfigs/status
   - resolverendpoints/status
   - resolverquerylogconfigassociations/status
+  - resolverquerylogconfigpolicies/status
   - resolverquerylogconfigs/status
   - resolverruleassociations/status
+  - resolverrulepolicies/status
   - resolverrules/status
   verbs:
   - get
   - patch
   - update
+- apiGroups:
+  - s3.services.k8s.aws
+  resources:
+  - buckets
+  - buckets/status
+  verbs:
+  - get
+  - list
 - apiGroups:
   - services.k8s.aws
   resources:
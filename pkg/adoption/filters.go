@@ -0,0 +1,159 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package adoption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/tags"
+)
+
+// TagFilterPrefix is the prefix of the filter names that match a tag of the
+// resource rather than one of the filters the List APIs support. The rest of
+// the name is the tag key.
+const TagFilterPrefix = "tag:"
+
+// Candidate is a resource returned by a List API that may be adopted.
+type Candidate struct {
+	ID  *string
+	ARN *string
+}
+
+// NeedsLookup returns true when the resource has to be found with its
+// adoption filters: it is being adopted, under either adoption policy, it has
+// adoption filters and its ID is not known yet. The adopt policy requires the
+// adoption-fields annotation, whose "id" the runtime copies into status, so
+// an empty "id" stands for an ID to look up.
+func NeedsLookup(
+	res acktypes.AWSResource,
+	id *string,
+	filters []*svcapitypes.Filter,
+) bool {
+	return len(filters) > 0 && (id == nil || *id == "") && ackrt.NeedAdoption(res)
+}
+
+// SplitFilters splits the adoption filters into the ones that are passed to
+// the List API and the tag filters, keyed by tag key, that are matched
+// against the tags of every listed resource.
+func SplitFilters(
+	filters []*svcapitypes.Filter,
+) (apiFilters []svcsdktypes.Filter, tagFilters map[string][]string) {
+	tagFilters = map[string][]string{}
+	for _, f := range filters {
+		if f == nil || f.Name == nil {
+			continue
+		}
+		values := make([]string, 0, len(f.Values))
+		for _, v := range f.Values {
+			if v != nil {
+				values = append(values, *v)
+			}
+		}
+		if key, ok := strings.CutPrefix(*f.Name, TagFilterPrefix); ok {
+			tagFilters[key] = values
+			continue
+		}
+		apiFilters = append(apiFilters, svcsdktypes.Filter{
+			Name:   f.Name,
+			Values: values,
+		})
+	}
+	return apiFilters, tagFilters
+}
+
+// FindOne returns the ID of the only candidate whose tags match every tag
+// filter. Matching more than one candidate is a terminal error, since the
+// resource to adopt is ambiguous. Matching none requeues: adoption filters ask
+// for an existing resource, so the controller waits for one to match rather
+// than create a new one.
+func FindOne(
+	ctx context.Context,
+	sdkapi *svcsdk.Client,
+	metrics *metrics.Metrics,
+	candidates []Candidate,
+	tagFilters map[string][]string,
+) (*string, error) {
+	matched := []string{}
+	var id *string
+	for _, c := range candidates {
+		if len(tagFilters) > 0 {
+			resourceTags, err := tags.GetTags(ctx, sdkapi, metrics, *c.ARN)
+			if err != nil {
+				return nil, err
+			}
+			if !matchesTags(resourceTags, tagFilters) {
+				continue
+			}
+		}
+		matched = append(matched, *c.ID)
+		id = c.ID
+	}
+	if len(matched) == 0 {
+		return nil, requeue.NeededAfter(
+			errors.New("adoption filters match no resource"),
+			requeue.DefaultRequeueAfterDuration,
+		)
+	}
+	if len(matched) > 1 {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"adoption filters match %d resources: %s",
+			len(matched), strings.Join(matched, ", "),
+		))
+	}
+	return id, nil
+}
+
+// matchesTags returns true if, for every tag filter, the resource has a tag
+// with that key and one of the filter values. A filter without values only
+// requires the key.
+func matchesTags(
+	resourceTags []*svcapitypes.Tag,
+	tagFilters map[string][]string,
+) bool {
+	for key, values := range tagFilters {
+		found := false
+		for _, t := range resourceTags {
+			if t.Key == nil || *t.Key != key {
+				continue
+			}
+			if len(values) == 0 {
+				found = true
+				break
+			}
+			for _, v := range values {
+				if t.Value != nil && *t.Value == v {
+					found = true
+					break
+				}
+			}
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,315 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	smithy "github.com/aws/smithy-go"
+	"github.com/samber/lo"
+)
+
+const (
+	// Version is the IAM policy language version of the documents built here.
+	Version = "2012-10-17"
+	// ActionPrefix is the service prefix of every Route 53 Resolver action.
+	ActionPrefix = "route53resolver:"
+)
+
+var accountIDPattern = regexp.MustCompile(`^[0-9]{12}$`)
+
+// Document is an IAM policy document attached to a Route 53 Resolver
+// resource to share it with other accounts.
+type Document struct {
+	Version   string      `json:"Version"`
+	Statement []Statement `json:"Statement"`
+}
+
+// Statement is a statement of a Document.
+type Statement struct {
+	Effect    string       `json:"Effect"`
+	Principal Principal    `json:"Principal"`
+	Action    StringOrList `json:"Action"`
+	Resource  StringOrList `json:"Resource"`
+}
+
+// Principal lists the AWS principals a Statement applies to.
+type Principal struct {
+	AWS StringOrList `json:"AWS"`
+}
+
+// StringOrList reads a policy element that IAM returns either as a single
+// string or as a list of strings.
+type StringOrList []string
+
+func (s *StringOrList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*s = []string{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
+
+// New returns the policy document that allows the accounts in principals to
+// perform actions, given without ActionPrefix, on the resource. It returns a
+// terminal error when the resource ARN is invalid or a principal is not an
+// account ID.
+func New(resourceARN string, principals []string, actions []string) (*string, error) {
+	parsed, err := arn.Parse(resourceARN)
+	if err != nil {
+		return nil, ackerr.NewTerminalError(fmt.Errorf("invalid resource ARN %q: %w", resourceARN, err))
+	}
+	if err := ValidateAccountIDs(principals); err != nil {
+		return nil, err
+	}
+	doc := Document{
+		Version: Version,
+		Statement: []Statement{
+			{
+				Effect: "Allow",
+				Principal: Principal{
+					AWS: lo.Map(principals, func(principal string, _ int) string {
+						return AccountRoot(parsed.Partition, principal)
+					}),
+				},
+				Action: lo.Map(actions, func(action string, _ int) string {
+					return ActionPrefix + action
+				}),
+				Resource: []string{resourceARN},
+			},
+		},
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return lo.ToPtr(string(data)), nil
+}
+
+// OwnerOnly returns the policy document that only allows the account owning
+// the resource to perform actions on it. A policy attached to a Route 53
+// Resolver resource cannot be deleted, so it is replaced with this document
+// to stop sharing the resource.
+func OwnerOnly(resourceARN string, actions []string) (*string, error) {
+	parsed, err := arn.Parse(resourceARN)
+	if err != nil {
+		return nil, ackerr.NewTerminalError(fmt.Errorf("invalid resource ARN %q: %w", resourceARN, err))
+	}
+	return New(resourceARN, []string{parsed.AccountID}, actions)
+}
+
+// IgnoreNotFound returns nil when err reports that the resource a policy is
+// attached to no longer exists, which leaves no policy to replace, and err
+// otherwise.
+func IgnoreNotFound(err error) error {
+	var awsErr smithy.APIError
+	if errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceNotFoundException" {
+		return nil
+	}
+	return err
+}
+
+// Fields are the paths of the fields of a policy resource that Compare
+// records differences on.
+type Fields struct {
+	// Principals is the path of the spec field listing the accounts the
+	// policy is shared with.
+	Principals string
+	// Actions is the path of the spec field listing the actions the policy
+	// grants, or empty when the resource always grants the same actions.
+	Actions string
+	// Document is the path of the status field holding the policy document
+	// read from AWS.
+	Document string
+}
+
+// Compare adds to delta the differences between the policy that allows the
+// accounts in principals to perform actions on the resource and the policy
+// document observed in AWS. Accounts and actions that differ are recorded on
+// the spec field listing them. Any other difference, such as a document that
+// cannot be parsed or one changed outside the controller in a way no spec
+// field describes, is recorded on the status field holding the document.
+// Formatting and ordering are not differences.
+func Compare(
+	delta *ackcompare.Delta,
+	fields Fields,
+	resourceARN string,
+	principals []string,
+	actions []string,
+	observed *string,
+) {
+	desired, _ := New(resourceARN, principals, actions)
+	if observed == nil {
+		delta.Add(fields.Document, desired, observed)
+		return
+	}
+	observedPrincipals, observedActions, err := Parse(*observed)
+	if err != nil {
+		delta.Add(fields.Document, desired, observed)
+		return
+	}
+	different := false
+	if !SameValues(principals, observedPrincipals) {
+		delta.Add(fields.Principals, principals, observedPrincipals)
+		different = true
+	}
+	if fields.Actions != "" && !SameValues(actions, observedActions) {
+		delta.Add(fields.Actions, actions, observedActions)
+		different = true
+	}
+	if different || desired == nil {
+		return
+	}
+	if equal, err := Equal(*desired, *observed); err != nil || !equal {
+		delta.Add(fields.Document, desired, observed)
+	}
+}
+
+// Principals returns the accounts a policy document read from AWS is shared
+// with, in the order of desired when both hold the same accounts and sorted
+// otherwise. A resource without a policy is reported as not found.
+func Principals(desired []*string, document *string) ([]*string, error) {
+	if lo.FromPtr(document) == "" {
+		return nil, ackerr.NotFound
+	}
+	principals, _, err := Parse(*document)
+	if err != nil {
+		return nil, err
+	}
+	if SameValues(aws.ToStringSlice(desired), principals) {
+		return desired, nil
+	}
+	sort.Strings(principals)
+	return aws.StringSlice(principals), nil
+}
+
+// ValidateAccountIDs returns a terminal error for the first principal that is
+// not a 12 digit account ID.
+func ValidateAccountIDs(principals []string) error {
+	for _, principal := range principals {
+		if !accountIDPattern.MatchString(principal) {
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"principal %q is not a 12 digit AWS account ID", principal,
+			))
+		}
+	}
+	return nil
+}
+
+// Parse returns the account IDs and the actions, without ActionPrefix, that
+// the Allow statements of a policy document grant.
+func Parse(document string) (principals []string, actions []string, err error) {
+	var doc Document
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, nil, fmt.Errorf("cannot parse policy document: %w", err)
+	}
+	principals = []string{}
+	actions = []string{}
+	for _, statement := range doc.Statement {
+		if statement.Effect != "Allow" {
+			continue
+		}
+		for _, principal := range statement.Principal.AWS {
+			principals = append(principals, AccountID(principal))
+		}
+		for _, action := range statement.Action {
+			actions = append(actions, strings.TrimPrefix(action, ActionPrefix))
+		}
+	}
+	return lo.Uniq(principals), lo.Uniq(actions), nil
+}
+
+// Equal returns true when two policy documents are semantically the same:
+// key order, white space, the order of list elements and a single string in
+// place of a one element list are not differences.
+func Equal(a string, b string) (bool, error) {
+	var docA, docB interface{}
+	if err := json.Unmarshal([]byte(a), &docA); err != nil {
+		return false, fmt.Errorf("cannot parse policy document: %w", err)
+	}
+	if err := json.Unmarshal([]byte(b), &docB); err != nil {
+		return false, fmt.Errorf("cannot parse policy document: %w", err)
+	}
+	return reflect.DeepEqual(normalize(docA), normalize(docB)), nil
+}
+
+// normalize returns a JSON value with every list sorted and deduplicated, and
+// every one element list replaced by its element.
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			out[key] = normalize(elem)
+		}
+		return out
+	case []interface{}:
+		keyed := map[string]interface{}{}
+		for _, elem := range v {
+			elem = normalize(elem)
+			data, _ := json.Marshal(elem)
+			keyed[string(data)] = elem
+		}
+		if len(keyed) == 1 {
+			for _, elem := range keyed {
+				return elem
+			}
+		}
+		keys := lo.Keys(keyed)
+		sort.Strings(keys)
+		out := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			out = append(out, keyed[key])
+		}
+		return out
+	}
+	return value
+}
+
+// AccountRoot returns the ARN of the root user of an account.
+func AccountRoot(partition string, accountID string) string {
+	return fmt.Sprintf("arn:%s:iam::%s:root", partition, accountID)
+}
+
+// AccountID returns the account ID of a principal given as an account ID or
+// as the ARN of an IAM identity.
+func AccountID(principal string) string {
+	if parsed, err := arn.Parse(principal); err == nil {
+		return parsed.AccountID
+	}
+	return principal
+}
+
+// SameValues returns true when a and b hold the same values, in any order.
+func SameValues(a []string, b []string) bool {
+	a = lo.Uniq(a)
+	b = lo.Uniq(b)
+	return len(a) == len(b) && lo.Every(a, b)
+}
@@ -0,0 +1,116 @@
+package policy
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/samber/lo"
+)
+
+const testRuleARN = "arn:aws:route53resolver:us-west-2:111111111111:resolver-rule/rslvr-rr-1"
+
+var testFields = Fields{
+	Principals: "Spec.Principals",
+	Actions:    "Spec.Actions",
+	Document:   "Status.ResolverRulePolicy",
+}
+
+func TestCompare(t *testing.T) {
+	mustNew := func(principals []string, actions []string) *string {
+		document, err := New(testRuleARN, principals, actions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return document
+	}
+	tests := []struct {
+		name     string
+		fields   Fields
+		observed *string
+		want     []string
+	}{
+		{
+			name:     "same document",
+			fields:   testFields,
+			observed: mustNew([]string{"333333333333", "222222222222"}, []string{"GetResolverRule"}),
+			want:     []string{},
+		},
+		{
+			name:     "principal added outside the controller",
+			fields:   testFields,
+			observed: mustNew([]string{"222222222222", "333333333333", "444444444444"}, []string{"GetResolverRule"}),
+			want:     []string{"Spec.Principals"},
+		},
+		{
+			name:     "action added outside the controller",
+			fields:   testFields,
+			observed: mustNew([]string{"222222222222", "333333333333"}, []string{"GetResolverRule", "ListResolverRules"}),
+			want:     []string{"Spec.Actions"},
+		},
+		{
+			name:     "action added to a policy without an actions field",
+			fields:   Fields{Principals: testFields.Principals, Document: testFields.Document},
+			observed: mustNew([]string{"222222222222", "333333333333"}, []string{"GetResolverRule", "ListResolverRules"}),
+			want:     []string{"Status.ResolverRulePolicy"},
+		},
+		{
+			name:     "principal given as an IAM user",
+			fields:   testFields,
+			observed: lo.ToPtr(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::222222222222:user/a","arn:aws:iam::333333333333:root"]},"Action":"route53resolver:GetResolverRule","Resource":"` + testRuleARN + `"}]}`),
+			want:     []string{"Status.ResolverRulePolicy"},
+		},
+		{
+			name:     "unparsable document",
+			fields:   testFields,
+			observed: lo.ToPtr("{"),
+			want:     []string{"Status.ResolverRulePolicy"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := ackcompare.NewDelta()
+			Compare(
+				delta,
+				tt.fields,
+				testRuleARN,
+				[]string{"222222222222", "333333333333"},
+				[]string{"GetResolverRule"},
+				tt.observed,
+			)
+			if len(delta.Differences) != len(tt.want) || !lo.EveryBy(tt.want, delta.DifferentAt) {
+				t.Errorf("Compare() differences = %v, want differences at %v", delta.Differences, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrincipals(t *testing.T) {
+	document, err := New(testRuleARN, []string{"333333333333", "222222222222"}, []string{"GetResolverRule"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Principals(aws.StringSlice([]string{"333333333333", "222222222222"}), document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"333333333333", "222222222222"}; !reflect.DeepEqual(aws.ToStringSlice(got), want) {
+		t.Errorf("Principals() = %v, want %v", aws.ToStringSlice(got), want)
+	}
+
+	got, err = Principals(aws.StringSlice([]string{"444444444444"}), document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"222222222222", "333333333333"}; !reflect.DeepEqual(aws.ToStringSlice(got), want) {
+		t.Errorf("Principals() = %v, want %v", aws.ToStringSlice(got), want)
+	}
+
+	if _, err = Principals(nil, lo.ToPtr("")); !errors.Is(err, ackerr.NotFound) {
+		t.Errorf("Principals() error = %v, want %v", err, ackerr.NotFound)
+	}
+}
@@ -0,0 +1,44 @@
+package firewall_config
+
+import (
+	"context"
+	"errors"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	smithy "github.com/aws/smithy-go"
+)
+
+// customDeleteFirewallConfig resets the firewall configuration of the VPC to
+// the AWS default, which is fail open disabled. A firewall configuration
+// cannot be deleted, so removing the CR only gives up management of it. A VPC
+// that no longer exists has nothing left to reset.
+func (rm *resourceManager) customDeleteFirewallConfig(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customDeleteFirewallConfig")
+	defer func() {
+		exit(err)
+	}()
+
+	if r.ko.Spec.VPCID == nil {
+		return nil, nil
+	}
+	input := &svcsdk.UpdateFirewallConfigInput{
+		FirewallFailOpen: svcsdktypes.FirewallFailOpenStatusDisabled,
+		ResourceId:       r.ko.Spec.VPCID,
+	}
+	_, err = rm.sdkapi.UpdateFirewallConfig(ctx, input)
+	rm.metrics.RecordAPICall("DELETE", "UpdateFirewallConfig", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceNotFoundException" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
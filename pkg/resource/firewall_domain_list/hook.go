@@ -0,0 +1,333 @@
+package firewall_domain_list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/tags"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	"github.com/samber/lo"
+)
+
+// maxDomainsPerUpdate is the largest number of domains that a single
+// UpdateFirewallDomains call accepts.
+const maxDomainsPerUpdate = 1000
+
+var (
+	ErrDomainSourceConflict = ackerr.NewTerminalError(
+		errors.New("only one of spec.domains or spec.domainFileURL can be set"),
+	)
+	RequeueWhileInProgress = requeue.NeededAfter(
+		errors.New("domain list is importing or updating domains"),
+		requeue.DefaultRequeueAfterDuration,
+	)
+	RequeueOnUpdate = requeue.NeededAfter(
+		errors.New("requeing to wait for domain list to finish updating"),
+		requeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// validateDomainSource returns a terminal error when the domain list sources
+// its domains both inline and from a file, since the two would overwrite each
+// other on every sync.
+func validateDomainSource(r *resource) error {
+	if r.ko.Spec.Domains != nil && r.ko.Spec.DomainFileURL != nil {
+		return ErrDomainSourceConflict
+	}
+	return nil
+}
+
+// isInProgress returns true while Route 53 Resolver is still importing or
+// updating the domains of the domain list. No other domain operation is
+// accepted in that state.
+func isInProgress(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	switch *r.ko.Status.Status {
+	case string(svcapitypes.FirewallDomainListStatus_SDK_IMPORTING),
+		string(svcapitypes.FirewallDomainListStatus_SDK_UPDATING):
+		return true
+	}
+	return false
+}
+
+// importFailedError returns a terminal error with the status message from
+// Route 53 Resolver when the import of the file at spec.domainFileURL failed.
+// Changing spec.domainFileURL, or switching to spec.domains, is not blocked,
+// so that the failed import can be replaced.
+func importFailedError(desired *resource, latest *resource) error {
+	if lo.FromPtr(latest.ko.Status.Status) != string(svcapitypes.FirewallDomainListStatus_SDK_COMPLETE_IMPORT_FAILED) {
+		return nil
+	}
+	if desired.ko.Spec.DomainFileURL == nil ||
+		lo.FromPtr(desired.ko.Spec.DomainFileURL) != lo.FromPtr(latest.ko.Status.ImportedDomainFileURL) {
+		return nil
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"importing domains from %s failed: %s",
+		*desired.ko.Spec.DomainFileURL, lo.FromPtr(latest.ko.Status.StatusMessage),
+	))
+}
+
+// normalizeDomain returns the form Route 53 Resolver compares domains in:
+// lower case and without the trailing root dot.
+func normalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+// domainSet returns the normalized set of the supplied domains.
+func domainSet(domains []*string) map[string]struct{} {
+	set := make(map[string]struct{}, len(domains))
+	for _, d := range domains {
+		if d != nil {
+			set[normalizeDomain(*d)] = struct{}{}
+		}
+	}
+	return set
+}
+
+// customPreCompare compares the domain sources of the domain list. Domains
+// are compared as a set, ignoring order, case and trailing dots.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	setA := domainSet(a.ko.Spec.Domains)
+	setB := domainSet(b.ko.Spec.Domains)
+	if len(setA) != len(setB) || len(lo.OmitByKeys(setA, lo.Keys(setB))) != 0 {
+		delta.Add("Spec.Domains", a.ko.Spec.Domains, b.ko.Spec.Domains)
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.DomainFileURL, b.ko.Spec.DomainFileURL) {
+		delta.Add("Spec.DomainFileURL", a.ko.Spec.DomainFileURL, b.ko.Spec.DomainFileURL)
+	} else if a.ko.Spec.DomainFileURL != nil && b.ko.Spec.DomainFileURL != nil {
+		if *a.ko.Spec.DomainFileURL != *b.ko.Spec.DomainFileURL {
+			delta.Add("Spec.DomainFileURL", a.ko.Spec.DomainFileURL, b.ko.Spec.DomainFileURL)
+		}
+	}
+}
+
+func (rm *resourceManager) customUpdateFirewallDomainList(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateFirewallDomainList")
+	defer func() {
+		exit(err)
+	}()
+
+	if err = validateDomainSource(desired); err != nil {
+		return nil, err
+	}
+	if isInProgress(latest) {
+		return nil, RequeueWhileInProgress
+	}
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	if !delta.DifferentExcept("Spec.Tags") {
+		return desired, nil
+	}
+
+	updated = rm.concreteResource(desired.DeepCopy())
+	updated.ko.Status = *latest.ko.Status.DeepCopy()
+
+	if delta.DifferentAt("Spec.DomainFileURL") && desired.ko.Spec.DomainFileURL != nil {
+		if err = rm.importDomains(ctx, updated); err != nil {
+			return nil, err
+		}
+		return updated, RequeueOnUpdate
+	}
+	if delta.DifferentAt("Spec.Domains") {
+		if err = rm.syncDomains(ctx, updated, latest.ko.Spec.Domains); err != nil {
+			return nil, err
+		}
+		return updated, RequeueOnUpdate
+	}
+	return updated, nil
+}
+
+// setDomainSource populates a freshly created domain list from whichever
+// domain source the spec uses.
+func (rm *resourceManager) setDomainSource(
+	ctx context.Context,
+	r *resource,
+) error {
+	if r.ko.Spec.DomainFileURL != nil {
+		return rm.importDomains(ctx, r)
+	}
+	if len(r.ko.Spec.Domains) > 0 {
+		return rm.syncDomains(ctx, r, nil)
+	}
+	return nil
+}
+
+// importDomains replaces the domains of the domain list with the contents of
+// the file at spec.domainFileURL and records the imported URL in the status,
+// since Route 53 Resolver does not return it.
+func (rm *resourceManager) importDomains(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.importDomains")
+	defer func() {
+		exit(err)
+	}()
+
+	input := &svcsdk.ImportFirewallDomainsInput{
+		DomainFileUrl:        r.ko.Spec.DomainFileURL,
+		FirewallDomainListId: r.ko.Status.ID,
+		Operation:            svcsdktypes.FirewallDomainImportOperationReplace,
+	}
+	resp, err := rm.sdkapi.ImportFirewallDomains(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ImportFirewallDomains", err)
+	if err != nil {
+		return err
+	}
+	r.ko.Status.ImportedDomainFileURL = r.ko.Spec.DomainFileURL
+	r.ko.Status.Status = lo.ToPtr(string(resp.Status))
+	r.ko.Status.StatusMessage = resp.StatusMessage
+	return nil
+}
+
+// syncDomains sends the next change needed to reconcile the domains of the
+// domain list against the supplied latest domains. Route 53 Resolver rejects
+// domain updates while the list is UPDATING, so a single UpdateFirewallDomains
+// call is made per reconcile; the caller requeues until the list is COMPLETE
+// again and the next change is sent.
+func (rm *resourceManager) syncDomains(
+	ctx context.Context,
+	r *resource,
+	latestDomains []*string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncDomains")
+	defer func() {
+		exit(err)
+	}()
+
+	operation, domains := nextDomainUpdate(domainSet(r.ko.Spec.Domains), domainSet(latestDomains))
+	if len(domains) > 0 {
+		if err = rm.updateDomains(ctx, r, operation, domains); err != nil {
+			return err
+		}
+	}
+	r.ko.Status.ImportedDomainFileURL = nil
+	return nil
+}
+
+// nextDomainUpdate returns the next operation and batch of domains that bring
+// the latest domains closer to the desired ones. Small changes are sent as ADD
+// batches followed by REMOVE batches of at most maxDomainsPerUpdate domains;
+// when the change set is larger than the desired list itself, a single
+// REPLACE is sent instead. It returns no domains when both sets are equal.
+func nextDomainUpdate(
+	desired map[string]struct{},
+	latest map[string]struct{},
+) (svcsdktypes.FirewallDomainUpdateOperation, []string) {
+	toAdd := lo.Keys(lo.OmitByKeys(desired, lo.Keys(latest)))
+	toRemove := lo.Keys(lo.OmitByKeys(latest, lo.Keys(desired)))
+	sort.Strings(toAdd)
+	sort.Strings(toRemove)
+
+	switch {
+	case len(toAdd)+len(toRemove) == 0:
+		return "", nil
+	case len(desired) > 0 && len(desired) <= maxDomainsPerUpdate &&
+		len(toAdd)+len(toRemove) > len(desired):
+		domains := lo.Keys(desired)
+		sort.Strings(domains)
+		return svcsdktypes.FirewallDomainUpdateOperationReplace, domains
+	case len(toAdd) > 0:
+		return svcsdktypes.FirewallDomainUpdateOperationAdd, lo.Subset(toAdd, 0, maxDomainsPerUpdate)
+	default:
+		return svcsdktypes.FirewallDomainUpdateOperationRemove, lo.Subset(toRemove, 0, maxDomainsPerUpdate)
+	}
+}
+
+// updateDomains calls UpdateFirewallDomains for the supplied domains.
+func (rm *resourceManager) updateDomains(
+	ctx context.Context,
+	r *resource,
+	operation svcsdktypes.FirewallDomainUpdateOperation,
+	domains []string,
+) error {
+	input := &svcsdk.UpdateFirewallDomainsInput{
+		Domains:              domains,
+		FirewallDomainListId: r.ko.Status.ID,
+		Operation:            operation,
+	}
+	resp, err := rm.sdkapi.UpdateFirewallDomains(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "UpdateFirewallDomains", err)
+	if err != nil {
+		return err
+	}
+	r.ko.Status.Status = lo.ToPtr(string(resp.Status))
+	r.ko.Status.StatusMessage = resp.StatusMessage
+	return nil
+}
+
+// listDomains returns every domain in the domain list.
+func (rm *resourceManager) listDomains(
+	ctx context.Context,
+	id *string,
+) (domains []*string, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.listDomains")
+	defer func() {
+		exit(err)
+	}()
+
+	domains = []*string{}
+	input := &svcsdk.ListFirewallDomainsInput{
+		FirewallDomainListId: id,
+	}
+	for {
+		resp, err := rm.sdkapi.ListFirewallDomains(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "ListFirewallDomains", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range resp.Domains {
+			domains = append(domains, lo.ToPtr(domain))
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	return domains, nil
+}
+
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	return tags.GetTags(ctx, rm.sdkapi, rm.metrics, resourceARN)
+}
+
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	return tags.SyncTags(ctx, desired.ko.Spec.Tags, latest.ko.Spec.Tags, latest.ko.Status.ACKResourceMetadata, convertToOrderedACKTags, rm.sdkapi, rm.metrics)
+}
@@ -0,0 +1,452 @@
+package firewall_rule_group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/tags"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	"github.com/samber/lo"
+)
+
+// validateRules returns a terminal error when a rule in the spec cannot be
+// created as written. Rules are identified by name, so names must be unique,
+// and each rule inspects either a domain list or a DNS threat category.
+func validateRules(r *resource) error {
+	names := map[string]struct{}{}
+	priorities := map[int64]struct{}{}
+	for idx, rule := range r.ko.Spec.Rules {
+		if rule.Name == nil || *rule.Name == "" {
+			return ackerr.NewTerminalError(fmt.Errorf("rules[%d]: name is required", idx))
+		}
+		name := *rule.Name
+		if _, ok := names[name]; ok {
+			return ackerr.NewTerminalError(fmt.Errorf("rules[%d]: duplicate rule name %q", idx, name))
+		}
+		names[name] = struct{}{}
+		if rule.Priority == nil {
+			return ackerr.NewTerminalError(fmt.Errorf("rule %q: priority is required", name))
+		}
+		if _, ok := priorities[*rule.Priority]; ok {
+			return ackerr.NewTerminalError(fmt.Errorf("rule %q: duplicate priority %d", name, *rule.Priority))
+		}
+		priorities[*rule.Priority] = struct{}{}
+		if rule.Action == nil {
+			return ackerr.NewTerminalError(fmt.Errorf("rule %q: action is required", name))
+		}
+		hasDomainList := rule.FirewallDomainListID != nil || rule.FirewallDomainListRef != nil
+		if hasDomainList == (rule.DNSThreatProtection != nil) {
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"rule %q: exactly one of firewallDomainListID, firewallDomainListRef or dnsThreatProtection must be set", name))
+		}
+		if (rule.DNSThreatProtection != nil) != (rule.ConfidenceThreshold != nil) {
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"rule %q: dnsThreatProtection and confidenceThreshold must be set together", name))
+		}
+	}
+	return nil
+}
+
+// defaultRedirectionAction is the FirewallDomainRedirectionAction Route 53
+// Resolver applies to a rule created without one.
+var defaultRedirectionAction = string(svcsdktypes.FirewallDomainRedirectionActionInspectRedirectionDomain)
+
+// equalOptional returns true when both values match, an unset value standing
+// for the default Route 53 Resolver applies to the field.
+func equalOptional[T comparable](desired, latest *T, def T) bool {
+	return lo.FromPtrOr(desired, def) == lo.FromPtrOr(latest, def)
+}
+
+// clearsOptional returns true when the desired rule unsets a field that is
+// set on the observed rule and has no default to update it to.
+func clearsOptional[T comparable](desired, latest *T) bool {
+	return desired == nil && latest != nil
+}
+
+// equalRule compares the fields of a desired rule that the user can set with
+// the observed rule. Read-only fields and references are ignored.
+func equalRule(desired, latest *svcapitypes.FirewallRule) bool {
+	return lo.FromPtr(desired.Action) == lo.FromPtr(latest.Action) &&
+		lo.FromPtr(desired.Priority) == lo.FromPtr(latest.Priority) &&
+		lo.FromPtr(desired.FirewallDomainListID) == lo.FromPtr(latest.FirewallDomainListID) &&
+		lo.FromPtr(desired.DNSThreatProtection) == lo.FromPtr(latest.DNSThreatProtection) &&
+		lo.FromPtr(desired.Qtype) == lo.FromPtr(latest.Qtype) &&
+		equalOptional(desired.BlockOverrideDNSType, latest.BlockOverrideDNSType, "") &&
+		equalOptional(desired.BlockOverrideDomain, latest.BlockOverrideDomain, "") &&
+		equalOptional(desired.BlockOverrideTTL, latest.BlockOverrideTTL, 0) &&
+		equalOptional(desired.BlockResponse, latest.BlockResponse, "") &&
+		equalOptional(desired.ConfidenceThreshold, latest.ConfidenceThreshold, "") &&
+		equalOptional(desired.FirewallDomainRedirectionAction, latest.FirewallDomainRedirectionAction, defaultRedirectionAction)
+}
+
+// requiresReplacement returns true when the observed rule cannot be updated
+// into the desired rule, either because the fields that identify a rule
+// inside its group changed or because the desired rule clears a field that
+// UpdateFirewallRule can only change, not unset.
+func requiresReplacement(desired, latest *svcapitypes.FirewallRule) bool {
+	return lo.FromPtr(desired.FirewallDomainListID) != lo.FromPtr(latest.FirewallDomainListID) ||
+		(desired.DNSThreatProtection == nil) != (latest.DNSThreatProtection == nil) ||
+		lo.FromPtr(desired.Qtype) != lo.FromPtr(latest.Qtype) ||
+		clearsOptional(desired.BlockOverrideDNSType, latest.BlockOverrideDNSType) ||
+		clearsOptional(desired.BlockOverrideDomain, latest.BlockOverrideDomain) ||
+		clearsOptional(desired.BlockOverrideTTL, latest.BlockOverrideTTL) ||
+		clearsOptional(desired.BlockResponse, latest.BlockResponse) ||
+		clearsOptional(desired.ConfidenceThreshold, latest.ConfidenceThreshold)
+}
+
+// rulesByName indexes the supplied rules by name.
+func rulesByName(rules []*svcapitypes.FirewallRule) map[string]*svcapitypes.FirewallRule {
+	return lo.SliceToMap(rules, func(rule *svcapitypes.FirewallRule) (string, *svcapitypes.FirewallRule) {
+		return lo.FromPtr(rule.Name), rule
+	})
+}
+
+// customPreCompare compares the rules of the rule group by name, so that the
+// order AWS returns them in does not matter.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if len(a.ko.Spec.Rules) != len(b.ko.Spec.Rules) {
+		delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+		return
+	}
+	latestRules := rulesByName(b.ko.Spec.Rules)
+	for _, desiredRule := range a.ko.Spec.Rules {
+		latestRule, ok := latestRules[lo.FromPtr(desiredRule.Name)]
+		if !ok || !equalRule(desiredRule, latestRule) {
+			delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+			return
+		}
+	}
+}
+
+func (rm *resourceManager) customUpdateFirewallRuleGroup(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateFirewallRuleGroup")
+	defer func() {
+		exit(err)
+	}()
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.Rules") {
+		if err = validateRules(desired); err != nil {
+			return nil, err
+		}
+		if err = rm.syncRules(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	updated = rm.concreteResource(desired.DeepCopy())
+	updated.ko.Status = *latest.ko.Status.DeepCopy()
+	return updated, nil
+}
+
+// rulePlan lists the rule calls that turn the observed rules of a rule group
+// into the desired ones, in the order they have to be made.
+type rulePlan struct {
+	// toDelete are the observed rules that are no longer desired or whose
+	// identifying fields changed. They are deleted first so that their
+	// priorities can be reused.
+	toDelete []*svcapitypes.FirewallRule
+	// toMove are copies of observed rules set to a temporary priority. They
+	// hold a priority another rule is updated to, and are moved out of the
+	// way before any rule is updated, so that swapping priorities never
+	// conflicts.
+	toMove []*svcapitypes.FirewallRule
+	// toUpdate are the desired rules that differ from the observed ones.
+	toUpdate []*svcapitypes.FirewallRule
+	// toCreate are the desired rules that do not exist yet or were deleted
+	// to be replaced.
+	toCreate []*svcapitypes.FirewallRule
+}
+
+// planRules computes the calls that reconcile the latest rules of a rule
+// group with the desired ones. Rules are matched by name.
+func planRules(desired, latest []*svcapitypes.FirewallRule) *rulePlan {
+	plan := &rulePlan{}
+	latestRules := rulesByName(latest)
+	desiredRules := rulesByName(desired)
+
+	for _, latestRule := range latest {
+		desiredRule, ok := desiredRules[lo.FromPtr(latestRule.Name)]
+		if !ok || requiresReplacement(desiredRule, latestRule) {
+			plan.toDelete = append(plan.toDelete, latestRule)
+		}
+	}
+
+	// Temporary priorities are taken above every priority in use, so that
+	// they cannot conflict with a rule that is not moved.
+	desiredPriorities := map[int64]string{}
+	for _, desiredRule := range desired {
+		desiredPriorities[lo.FromPtr(desiredRule.Priority)] = lo.FromPtr(desiredRule.Name)
+	}
+	maxPriority := int64(0)
+	for _, rule := range lo.Flatten([][]*svcapitypes.FirewallRule{desired, latest}) {
+		if p := lo.FromPtr(rule.Priority); p > maxPriority {
+			maxPriority = p
+		}
+	}
+
+	for _, desiredRule := range desired {
+		name := lo.FromPtr(desiredRule.Name)
+		latestRule, ok := latestRules[name]
+		switch {
+		case !ok, requiresReplacement(desiredRule, latestRule):
+			plan.toCreate = append(plan.toCreate, desiredRule)
+		case !equalRule(desiredRule, latestRule):
+			plan.toUpdate = append(plan.toUpdate, desiredRule)
+			owner, taken := desiredPriorities[lo.FromPtr(latestRule.Priority)]
+			if taken && owner != name {
+				maxPriority++
+				moved := latestRule.DeepCopy()
+				moved.Priority = lo.ToPtr(maxPriority)
+				plan.toMove = append(plan.toMove, moved)
+			}
+		}
+	}
+	return plan
+}
+
+// syncRules reconciles the rules of the rule group one by one, following the
+// plan computed by planRules.
+func (rm *resourceManager) syncRules(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncRules")
+	defer func() {
+		exit(err)
+	}()
+
+	groupID := desired.ko.Status.ID
+	latestRules := []*svcapitypes.FirewallRule{}
+	if latest != nil {
+		groupID = latest.ko.Status.ID
+		latestRules = latest.ko.Spec.Rules
+	}
+	plan := planRules(desired.ko.Spec.Rules, latestRules)
+	latestByName := rulesByName(latestRules)
+
+	for _, latestRule := range plan.toDelete {
+		if err = rm.deleteRule(ctx, groupID, latestRule); err != nil {
+			return err
+		}
+	}
+	for _, movedRule := range plan.toMove {
+		if err = rm.updateRule(ctx, groupID, movedRule, latestByName[*movedRule.Name]); err != nil {
+			return err
+		}
+	}
+	for _, desiredRule := range plan.toUpdate {
+		if err = rm.updateRule(ctx, groupID, desiredRule, latestByName[*desiredRule.Name]); err != nil {
+			return err
+		}
+	}
+	for _, desiredRule := range plan.toCreate {
+		if err = rm.createRule(ctx, groupID, desiredRule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rm *resourceManager) createRule(
+	ctx context.Context,
+	groupID *string,
+	rule *svcapitypes.FirewallRule,
+) error {
+	input := &svcsdk.CreateFirewallRuleInput{
+		Action:                          svcsdktypes.Action(lo.FromPtr(rule.Action)),
+		BlockOverrideDnsType:            svcsdktypes.BlockOverrideDnsType(lo.FromPtr(rule.BlockOverrideDNSType)),
+		BlockOverrideDomain:             rule.BlockOverrideDomain,
+		BlockOverrideTtl:                toInt32Ptr(rule.BlockOverrideTTL),
+		BlockResponse:                   svcsdktypes.BlockResponse(lo.FromPtr(rule.BlockResponse)),
+		ConfidenceThreshold:             svcsdktypes.ConfidenceThreshold(lo.FromPtr(rule.ConfidenceThreshold)),
+		DnsThreatProtection:             svcsdktypes.DnsThreatProtection(lo.FromPtr(rule.DNSThreatProtection)),
+		FirewallDomainListId:            rule.FirewallDomainListID,
+		FirewallDomainRedirectionAction: svcsdktypes.FirewallDomainRedirectionAction(lo.FromPtr(rule.FirewallDomainRedirectionAction)),
+		FirewallRuleGroupId:             groupID,
+		Name:                            rule.Name,
+		Priority:                        toInt32Ptr(rule.Priority),
+		Qtype:                           rule.Qtype,
+	}
+	_, err := rm.sdkapi.CreateFirewallRule(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "CreateFirewallRule", err)
+	return err
+}
+
+func (rm *resourceManager) updateRule(
+	ctx context.Context,
+	groupID *string,
+	desired *svcapitypes.FirewallRule,
+	latest *svcapitypes.FirewallRule,
+) error {
+	input := &svcsdk.UpdateFirewallRuleInput{
+		Action:                          svcsdktypes.Action(lo.FromPtr(desired.Action)),
+		BlockOverrideDnsType:            svcsdktypes.BlockOverrideDnsType(lo.FromPtr(desired.BlockOverrideDNSType)),
+		BlockOverrideDomain:             desired.BlockOverrideDomain,
+		BlockOverrideTtl:                toInt32Ptr(desired.BlockOverrideTTL),
+		BlockResponse:                   svcsdktypes.BlockResponse(lo.FromPtr(desired.BlockResponse)),
+		ConfidenceThreshold:             svcsdktypes.ConfidenceThreshold(lo.FromPtr(desired.ConfidenceThreshold)),
+		DnsThreatProtection:             svcsdktypes.DnsThreatProtection(lo.FromPtr(desired.DNSThreatProtection)),
+		FirewallDomainListId:            latest.FirewallDomainListID,
+		FirewallDomainRedirectionAction: svcsdktypes.FirewallDomainRedirectionAction(lo.FromPtrOr(desired.FirewallDomainRedirectionAction, defaultRedirectionAction)),
+		FirewallRuleGroupId:             groupID,
+		FirewallThreatProtectionId:      latest.FirewallThreatProtectionID,
+		Name:                            desired.Name,
+		Priority:                        toInt32Ptr(desired.Priority),
+		Qtype:                           latest.Qtype,
+	}
+	_, err := rm.sdkapi.UpdateFirewallRule(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "UpdateFirewallRule", err)
+	return err
+}
+
+func (rm *resourceManager) deleteRule(
+	ctx context.Context,
+	groupID *string,
+	rule *svcapitypes.FirewallRule,
+) error {
+	input := &svcsdk.DeleteFirewallRuleInput{
+		FirewallDomainListId:       rule.FirewallDomainListID,
+		FirewallRuleGroupId:        groupID,
+		FirewallThreatProtectionId: rule.FirewallThreatProtectionID,
+		Qtype:                      rule.Qtype,
+	}
+	_, err := rm.sdkapi.DeleteFirewallRule(ctx, input)
+	rm.metrics.RecordAPICall("DELETE", "DeleteFirewallRule", err)
+	var notFound *svcsdktypes.ResourceNotFoundException
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	return err
+}
+
+// listRules returns every rule in the rule group, ordered by priority.
+func (rm *resourceManager) listRules(
+	ctx context.Context,
+	groupID *string,
+) (rules []*svcapitypes.FirewallRule, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.listRules")
+	defer func() {
+		exit(err)
+	}()
+
+	rules = []*svcapitypes.FirewallRule{}
+	input := &svcsdk.ListFirewallRulesInput{
+		FirewallRuleGroupId: groupID,
+	}
+	for {
+		resp, err := rm.sdkapi.ListFirewallRules(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "ListFirewallRules", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range resp.FirewallRules {
+			rules = append(rules, newRule(rule))
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	return rules, nil
+}
+
+// newRule converts a rule returned by ListFirewallRules into its custom
+// resource representation.
+func newRule(rule svcsdktypes.FirewallRule) *svcapitypes.FirewallRule {
+	res := &svcapitypes.FirewallRule{
+		BlockOverrideDomain:        rule.BlockOverrideDomain,
+		CreationTime:               rule.CreationTime,
+		CreatorRequestID:           rule.CreatorRequestId,
+		FirewallDomainListID:       rule.FirewallDomainListId,
+		FirewallRuleGroupID:        rule.FirewallRuleGroupId,
+		FirewallThreatProtectionID: rule.FirewallThreatProtectionId,
+		ModificationTime:           rule.ModificationTime,
+		Name:                       rule.Name,
+		Qtype:                      rule.Qtype,
+	}
+	if rule.Action != "" {
+		res.Action = lo.ToPtr(string(rule.Action))
+	}
+	if rule.BlockOverrideDnsType != "" {
+		res.BlockOverrideDNSType = lo.ToPtr(string(rule.BlockOverrideDnsType))
+	}
+	if rule.BlockOverrideTtl != nil {
+		res.BlockOverrideTTL = lo.ToPtr(int64(*rule.BlockOverrideTtl))
+	}
+	if rule.BlockResponse != "" {
+		res.BlockResponse = lo.ToPtr(string(rule.BlockResponse))
+	}
+	if rule.ConfidenceThreshold != "" {
+		res.ConfidenceThreshold = lo.ToPtr(string(rule.ConfidenceThreshold))
+	}
+	if rule.DnsThreatProtection != "" {
+		res.DNSThreatProtection = lo.ToPtr(string(rule.DnsThreatProtection))
+	}
+	if rule.FirewallDomainRedirectionAction != "" {
+		res.FirewallDomainRedirectionAction = lo.ToPtr(string(rule.FirewallDomainRedirectionAction))
+	}
+	if rule.Priority != nil {
+		res.Priority = lo.ToPtr(int64(*rule.Priority))
+	}
+	return res
+}
+
+// setRuleReferences copies the domain list references of the desired rules
+// onto the matching observed rules, since AWS only returns the resolved IDs.
+func setRuleReferences(
+	latest []*svcapitypes.FirewallRule,
+	desired []*svcapitypes.FirewallRule,
+) {
+	desiredRules := rulesByName(desired)
+	for _, rule := range latest {
+		if desiredRule, ok := desiredRules[lo.FromPtr(rule.Name)]; ok {
+			rule.FirewallDomainListRef = desiredRule.FirewallDomainListRef
+		}
+	}
+}
+
+func toInt32Ptr(v *int64) *int32 {
+	if v == nil {
+		return nil
+	}
+	return lo.ToPtr(int32(*v))
+}
+
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	return tags.GetTags(ctx, rm.sdkapi, rm.metrics, resourceARN)
+}
+
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	return tags.SyncTags(ctx, desired.ko.Spec.Tags, latest.ko.Spec.Tags, latest.ko.Status.ACKResourceMetadata, convertToOrderedACKTags, rm.sdkapi, rm.metrics)
+}
@@ -0,0 +1,132 @@
+package firewall_rule_group
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+)
+
+func newTestRule(name string, priority int64) *svcapitypes.FirewallRule {
+	return &svcapitypes.FirewallRule{
+		Name:                 lo.ToPtr(name),
+		Priority:             lo.ToPtr(priority),
+		Action:               lo.ToPtr("BLOCK"),
+		BlockResponse:        lo.ToPtr("NODATA"),
+		FirewallDomainListID: lo.ToPtr("rslvr-fdl-" + name),
+	}
+}
+
+// applyPlan replays the plan against the priorities held by the latest
+// rules and fails the test when a call would reuse a priority held by
+// another rule. It returns the priority each rule ends up with.
+func applyPlan(
+	t *testing.T,
+	plan *rulePlan,
+	latest []*svcapitypes.FirewallRule,
+) map[string]int64 {
+	t.Helper()
+	held := map[string]int64{}
+	for _, rule := range latest {
+		held[*rule.Name] = *rule.Priority
+	}
+	set := func(rule *svcapitypes.FirewallRule) {
+		for name, priority := range held {
+			if name != *rule.Name && priority == *rule.Priority {
+				t.Fatalf("rule %q set to priority %d held by rule %q", *rule.Name, priority, name)
+			}
+		}
+		held[*rule.Name] = *rule.Priority
+	}
+	for _, rule := range plan.toDelete {
+		delete(held, *rule.Name)
+	}
+	for _, rule := range plan.toMove {
+		set(rule)
+	}
+	for _, rule := range plan.toUpdate {
+		set(rule)
+	}
+	for _, rule := range plan.toCreate {
+		set(rule)
+	}
+	return held
+}
+
+func TestPlanRules_SwapPriorities(t *testing.T) {
+	latest := []*svcapitypes.FirewallRule{
+		newTestRule("a", 100),
+		newTestRule("b", 200),
+		newTestRule("c", 300),
+	}
+	desired := []*svcapitypes.FirewallRule{
+		newTestRule("a", 200),
+		newTestRule("b", 100),
+		newTestRule("c", 300),
+	}
+
+	plan := planRules(desired, latest)
+	if len(plan.toDelete) != 0 || len(plan.toCreate) != 0 {
+		t.Fatalf("expected no rule to be replaced, got %d deletes and %d creates",
+			len(plan.toDelete), len(plan.toCreate))
+	}
+	if len(plan.toMove) != 2 {
+		t.Fatalf("expected both swapped rules to be moved, got %d", len(plan.toMove))
+	}
+	for _, rule := range plan.toMove {
+		if *rule.Priority <= 300 {
+			t.Errorf("rule %q moved to priority %d, which may be in use", *rule.Name, *rule.Priority)
+		}
+	}
+
+	held := applyPlan(t, plan, latest)
+	want := map[string]int64{"a": 200, "b": 100, "c": 300}
+	for name, priority := range want {
+		if held[name] != priority {
+			t.Errorf("rule %q: expected priority %d, got %d", name, priority, held[name])
+		}
+	}
+}
+
+func TestPlanRules_ShiftPriorities(t *testing.T) {
+	latest := []*svcapitypes.FirewallRule{
+		newTestRule("a", 100),
+		newTestRule("b", 200),
+	}
+	desired := []*svcapitypes.FirewallRule{
+		newTestRule("new", 100),
+		newTestRule("a", 200),
+		newTestRule("b", 300),
+	}
+
+	plan := planRules(desired, latest)
+	held := applyPlan(t, plan, latest)
+	want := map[string]int64{"new": 100, "a": 200, "b": 300}
+	for name, priority := range want {
+		if held[name] != priority {
+			t.Errorf("rule %q: expected priority %d, got %d", name, priority, held[name])
+		}
+	}
+}
+
+func TestPlanRules_ClearOptionalField(t *testing.T) {
+	latestRule := newTestRule("a", 100)
+	latestRule.BlockResponse = lo.ToPtr("OVERRIDE")
+	latestRule.BlockOverrideDomain = lo.ToPtr("example.com.")
+	latestRule.FirewallDomainRedirectionAction = lo.ToPtr(defaultRedirectionAction)
+	desiredRule := newTestRule("a", 100)
+
+	plan := planRules([]*svcapitypes.FirewallRule{desiredRule}, []*svcapitypes.FirewallRule{latestRule})
+	if len(plan.toDelete) != 1 || len(plan.toCreate) != 1 {
+		t.Fatalf("expected the rule to be replaced, got %d deletes and %d creates",
+			len(plan.toDelete), len(plan.toCreate))
+	}
+
+	latestRule.BlockOverrideDomain = nil
+	latestRule.BlockResponse = lo.ToPtr("NODATA")
+	plan = planRules([]*svcapitypes.FirewallRule{desiredRule}, []*svcapitypes.FirewallRule{latestRule})
+	if len(plan.toDelete)+len(plan.toMove)+len(plan.toUpdate)+len(plan.toCreate) != 0 {
+		t.Fatalf("expected an unset redirection action to match the default, got %+v", plan)
+	}
+}
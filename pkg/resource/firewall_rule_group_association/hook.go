@@ -0,0 +1,39 @@
+package firewall_rule_group_association
+
+import (
+	"context"
+	"errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/tags"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+)
+
+var (
+	RequeueWhileUpdating = requeue.NeededAfter(
+		errors.New("firewall rule group association is updating"),
+		requeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// isUpdating returns true while Route 53 Resolver is still applying a previous
+// change to the association. Further updates are rejected in that state.
+func isUpdating(r *resource) bool {
+	return r.ko.Status.Status != nil &&
+		*r.ko.Status.Status == string(svcapitypes.FirewallRuleGroupAssociationStatus_SDK_UPDATING)
+}
+
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	return tags.GetTags(ctx, rm.sdkapi, rm.metrics, resourceARN)
+}
+
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	return tags.SyncTags(ctx, desired.ko.Spec.Tags, latest.ko.Spec.Tags, latest.ko.Status.ACKResourceMetadata, convertToOrderedACKTags, rm.sdkapi, rm.metrics)
+}
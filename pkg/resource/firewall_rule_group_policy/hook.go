@@ -0,0 +1,132 @@
+package firewall_rule_group_policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/policy"
+)
+
+// sharedActions are the actions a rule group policy grants to every
+// principal.
+var sharedActions = []string{
+	"AssociateFirewallRuleGroup",
+	"DisassociateFirewallRuleGroup",
+	"GetFirewallRuleGroup",
+	"ListFirewallRules",
+}
+
+// newPolicyDocument builds the rule group policy that grants the accounts in
+// spec.principals the shared actions on the rule group.
+func newPolicyDocument(ko *svcapitypes.FirewallRuleGroupPolicy) (*string, error) {
+	return policy.New(
+		aws.ToString(ko.Spec.FirewallRuleGroupARN),
+		aws.ToStringSlice(ko.Spec.Principals),
+		sharedActions,
+	)
+}
+
+// customPreCompare compares the policy built from the desired spec with the
+// policy read from AWS, so that accounts or actions changed outside the
+// controller are differences while formatting and ordering are not.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	policy.Compare(
+		delta,
+		policy.Fields{
+			Principals: "Spec.Principals",
+			Document:   "Status.FirewallRuleGroupPolicy",
+		},
+		aws.ToString(a.ko.Spec.FirewallRuleGroupARN),
+		aws.ToStringSlice(a.ko.Spec.Principals),
+		sharedActions,
+		b.ko.Status.FirewallRuleGroupPolicy,
+	)
+}
+
+// setPrincipals sets spec.principals from the policy read from AWS.
+func setPrincipals(
+	desired *svcapitypes.FirewallRuleGroupPolicy,
+	ko *svcapitypes.FirewallRuleGroupPolicy,
+) error {
+	principals, err := policy.Principals(desired.Spec.Principals, ko.Status.FirewallRuleGroupPolicy)
+	if err != nil {
+		return err
+	}
+	ko.Spec.Principals = principals
+	return nil
+}
+
+// customDeleteFirewallRuleGroupPolicy stops sharing the rule group by
+// replacing its policy with one that only grants the account owning it.
+func (rm *resourceManager) customDeleteFirewallRuleGroupPolicy(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customDeleteFirewallRuleGroupPolicy")
+	defer func() {
+		exit(err)
+	}()
+
+	if r.ko.Spec.FirewallRuleGroupARN == nil {
+		return nil, nil
+	}
+	document, err := policy.OwnerOnly(*r.ko.Spec.FirewallRuleGroupARN, sharedActions)
+	if err != nil {
+		return nil, err
+	}
+	input := &svcsdk.PutFirewallRuleGroupPolicyInput{
+		Arn:                     r.ko.Spec.FirewallRuleGroupARN,
+		FirewallRuleGroupPolicy: document,
+	}
+	_, err = rm.sdkapi.PutFirewallRuleGroupPolicy(ctx, input)
+	rm.metrics.RecordAPICall("DELETE", "PutFirewallRuleGroupPolicy", err)
+	return nil, policy.IgnoreNotFound(err)
+}
+
+// setShareStatus sets status.ownerID and status.shareStatus from the rule
+// group the policy is attached to, so that the share state RAM reports for
+// the rule group can be observed on the policy.
+func (rm *resourceManager) setShareStatus(
+	ctx context.Context,
+	ko *svcapitypes.FirewallRuleGroupPolicy,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.setShareStatus")
+	defer func() {
+		exit(err)
+	}()
+
+	groupARN, err := arn.Parse(aws.ToString(ko.Spec.FirewallRuleGroupARN))
+	if err != nil {
+		return ackerr.NewTerminalError(fmt.Errorf("invalid firewallRuleGroupARN: %w", err))
+	}
+	groupID := groupARN.Resource[strings.LastIndex(groupARN.Resource, "/")+1:]
+	resp, err := rm.sdkapi.GetFirewallRuleGroup(ctx, &svcsdk.GetFirewallRuleGroupInput{
+		FirewallRuleGroupId: aws.String(groupID),
+	})
+	rm.metrics.RecordAPICall("READ_ONE", "GetFirewallRuleGroup", err)
+	if err != nil {
+		return err
+	}
+	ko.Status.OwnerID = resp.FirewallRuleGroup.OwnerId
+	if resp.FirewallRuleGroup.ShareStatus != "" {
+		ko.Status.ShareStatus = aws.String(string(resp.FirewallRuleGroup.ShareStatus))
+	} else {
+		ko.Status.ShareStatus = nil
+	}
+	return nil
+}
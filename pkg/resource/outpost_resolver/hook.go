@@ -0,0 +1,135 @@
+package outpost_resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/tags"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/samber/lo"
+)
+
+var (
+	RequeueWhileTransitioning = requeue.NeededAfter(
+		errors.New("outpost resolver is creating, updating or deleting"),
+		requeue.DefaultRequeueAfterDuration,
+	)
+	RequeueToPersistCreatorRequestId = requeue.Needed(
+		errors.New("requeuing to persist the creator request ID before creating the outpost resolver"),
+	)
+)
+
+// getCreatorRequestId will generate a CreatorRequestId for a given outpost
+// resolver from the UID and generation of the object, so that every create
+// attempt for the same generation uses the same value
+func getCreatorRequestId(resolver *svcapitypes.OutpostResolver) *string {
+	requestId := fmt.Sprintf("%s-%d", resolver.UID, resolver.Generation)
+	return &requestId
+}
+
+// ensureCreatorRequestId stores the CreatorRequestId of the next create call
+// in status. When the stored value changes it returns
+// RequeueToPersistCreatorRequestId, so that the value is saved to the cluster
+// before the outpost resolver is created and a retried create can find it.
+func ensureCreatorRequestId(r *resource) error {
+	requestId := getCreatorRequestId(r.ko)
+	if lo.FromPtr(r.ko.Status.CreatorRequestID) == *requestId {
+		return nil
+	}
+	r.ko.Status.CreatorRequestID = requestId
+	return RequeueToPersistCreatorRequestId
+}
+
+// findByCreatorRequestId looks up the outpost resolver created with the
+// CreatorRequestId stored in status. ListOutpostResolvers has no filters, so
+// the resolvers of the Outpost are paged through. It returns a copy of the
+// resource with the ID of that resolver, so that a resolver created by an
+// earlier attempt is adopted instead of created again, or the resource
+// itself if there is none.
+func (rm *resourceManager) findByCreatorRequestId(
+	ctx context.Context,
+	r *resource,
+) (found *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.findByCreatorRequestId")
+	defer exit(err)
+
+	input := &svcsdk.ListOutpostResolversInput{
+		OutpostArn: r.ko.Spec.OutpostARN,
+	}
+	for {
+		resp, err := rm.sdkapi.ListOutpostResolvers(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "ListOutpostResolvers", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, resolver := range resp.OutpostResolvers {
+			if lo.FromPtr(resolver.CreatorRequestId) == *r.ko.Status.CreatorRequestID {
+				found = &resource{r.ko.DeepCopy()}
+				found.ko.Status.ID = resolver.Id
+				return found, nil
+			}
+		}
+		if resp.NextToken == nil {
+			return r, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+// isTransitioning returns true while Route 53 Resolver is still creating,
+// updating or deleting the outpost resolver. Updates are rejected in those
+// states.
+func isTransitioning(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	switch *r.ko.Status.Status {
+	case string(svcapitypes.OutpostResolverStatus_SDK_CREATING),
+		string(svcapitypes.OutpostResolverStatus_SDK_UPDATING),
+		string(svcapitypes.OutpostResolverStatus_SDK_DELETING):
+		return true
+	}
+	return false
+}
+
+// failedStatusError returns a terminal error when the outpost resolver could
+// not be created or deleted. Route 53 Resolver does not recover from either
+// state on its own.
+func failedStatusError(r *resource) error {
+	if r.ko.Status.Status == nil {
+		return nil
+	}
+	switch *r.ko.Status.Status {
+	case string(svcapitypes.OutpostResolverStatus_SDK_FAILED_CREATION),
+		string(svcapitypes.OutpostResolverStatus_SDK_FAILED_DELETION):
+		msg := ""
+		if r.ko.Status.StatusMessage != nil {
+			msg = *r.ko.Status.StatusMessage
+		}
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"outpost resolver is in status %s: %s", *r.ko.Status.Status, msg,
+		))
+	}
+	return nil
+}
+
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	return tags.GetTags(ctx, rm.sdkapi, rm.metrics, resourceARN)
+}
+
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	return tags.SyncTags(ctx, desired.ko.Spec.Tags, latest.ko.Spec.Tags, latest.ko.Status.ACKResourceMetadata, convertToOrderedACKTags, rm.sdkapi, rm.metrics)
+}
@@ -0,0 +1,95 @@
+package resolver_config
+
+import (
+	"context"
+	"errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	smithy "github.com/aws/smithy-go"
+)
+
+var (
+	RequeueWhileTransitioning = requeue.NeededAfter(
+		errors.New("autodefined reverse setting is still being applied"),
+		requeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// autodefinedReverseFlags maps every autodefined reverse status to the flag
+// that produces it. GetResolverConfig only reports the status, so the flag in
+// the spec has to be derived from it.
+var autodefinedReverseFlags = map[svcapitypes.ResolverAutodefinedReverseStatus]svcapitypes.AutodefinedReverseFlag{
+	svcapitypes.ResolverAutodefinedReverseStatus_ENABLED:                                svcapitypes.AutodefinedReverseFlag_ENABLE,
+	svcapitypes.ResolverAutodefinedReverseStatus_ENABLING:                               svcapitypes.AutodefinedReverseFlag_ENABLE,
+	svcapitypes.ResolverAutodefinedReverseStatus_DISABLED:                               svcapitypes.AutodefinedReverseFlag_DISABLE,
+	svcapitypes.ResolverAutodefinedReverseStatus_DISABLING:                              svcapitypes.AutodefinedReverseFlag_DISABLE,
+	svcapitypes.ResolverAutodefinedReverseStatus_USE_LOCAL_RESOURCE_SETTING:             svcapitypes.AutodefinedReverseFlag_USE_LOCAL_RESOURCE_SETTING,
+	svcapitypes.ResolverAutodefinedReverseStatus_UPDATING_TO_USE_LOCAL_RESOURCE_SETTING: svcapitypes.AutodefinedReverseFlag_USE_LOCAL_RESOURCE_SETTING,
+}
+
+// setAutodefinedReverseFlag sets spec.autodefinedReverseFlag to the flag
+// matching the observed autodefined reverse status.
+func setAutodefinedReverseFlag(ko *svcapitypes.ResolverConfig) {
+	if ko.Status.AutodefinedReverse == nil {
+		return
+	}
+	status := svcapitypes.ResolverAutodefinedReverseStatus(*ko.Status.AutodefinedReverse)
+	if flag, ok := autodefinedReverseFlags[status]; ok {
+		ko.Spec.AutodefinedReverseFlag = (*string)(&flag)
+	}
+}
+
+// isTransitioning returns true while Route 53 Resolver is still applying a
+// previous change to the autodefined reverse setting.
+func isTransitioning(r *resource) bool {
+	if r.ko.Status.AutodefinedReverse == nil {
+		return false
+	}
+	switch svcapitypes.ResolverAutodefinedReverseStatus(*r.ko.Status.AutodefinedReverse) {
+	case svcapitypes.ResolverAutodefinedReverseStatus_ENABLING,
+		svcapitypes.ResolverAutodefinedReverseStatus_DISABLING,
+		svcapitypes.ResolverAutodefinedReverseStatus_UPDATING_TO_USE_LOCAL_RESOURCE_SETTING:
+		return true
+	}
+	return false
+}
+
+// customDeleteResolverConfig resets the autodefined reverse setting of the VPC
+// to the AWS default, which is enabled. A Resolver configuration cannot be
+// deleted, so removing the CR only gives up management of it. A VPC that no
+// longer exists has nothing left to reset.
+func (rm *resourceManager) customDeleteResolverConfig(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customDeleteResolverConfig")
+	defer func() {
+		exit(err)
+	}()
+
+	if r.ko.Spec.VPCID == nil {
+		return nil, nil
+	}
+	if isTransitioning(r) {
+		return r, RequeueWhileTransitioning
+	}
+	input := &svcsdk.UpdateResolverConfigInput{
+		AutodefinedReverseFlag: svcsdktypes.AutodefinedReverseFlagEnable,
+		ResourceId:             r.ko.Spec.VPCID,
+	}
+	_, err = rm.sdkapi.UpdateResolverConfig(ctx, input)
+	rm.metrics.RecordAPICall("DELETE", "UpdateResolverConfig", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceNotFoundException" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,95 @@
+package resolver_dnssec_config
+
+import (
+	"context"
+	"errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	smithy "github.com/aws/smithy-go"
+)
+
+var (
+	RequeueWhileTransitioning = requeue.NeededAfter(
+		errors.New("DNSSEC validation setting is still being applied"),
+		requeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// validations maps every DNSSEC validation status to the validation value
+// that produces it. GetResolverDnssecConfig only reports the status, so the
+// value in the spec has to be derived from it.
+var validations = map[svcapitypes.ResolverDNSSECValidationStatus]svcapitypes.Validation{
+	svcapitypes.ResolverDNSSECValidationStatus_ENABLED:                                svcapitypes.Validation_ENABLE,
+	svcapitypes.ResolverDNSSECValidationStatus_ENABLING:                               svcapitypes.Validation_ENABLE,
+	svcapitypes.ResolverDNSSECValidationStatus_DISABLED:                               svcapitypes.Validation_DISABLE,
+	svcapitypes.ResolverDNSSECValidationStatus_DISABLING:                              svcapitypes.Validation_DISABLE,
+	svcapitypes.ResolverDNSSECValidationStatus_USE_LOCAL_RESOURCE_SETTING:             svcapitypes.Validation_USE_LOCAL_RESOURCE_SETTING,
+	svcapitypes.ResolverDNSSECValidationStatus_UPDATING_TO_USE_LOCAL_RESOURCE_SETTING: svcapitypes.Validation_USE_LOCAL_RESOURCE_SETTING,
+}
+
+// setValidation sets spec.validation to the value matching the observed
+// validation status.
+func setValidation(ko *svcapitypes.ResolverDNSSECConfig) {
+	if ko.Status.ValidationStatus == nil {
+		return
+	}
+	status := svcapitypes.ResolverDNSSECValidationStatus(*ko.Status.ValidationStatus)
+	if validation, ok := validations[status]; ok {
+		ko.Spec.Validation = (*string)(&validation)
+	}
+}
+
+// isTransitioning returns true while Route 53 Resolver is still applying a
+// previous change to the DNSSEC validation setting.
+func isTransitioning(r *resource) bool {
+	if r.ko.Status.ValidationStatus == nil {
+		return false
+	}
+	switch svcapitypes.ResolverDNSSECValidationStatus(*r.ko.Status.ValidationStatus) {
+	case svcapitypes.ResolverDNSSECValidationStatus_ENABLING,
+		svcapitypes.ResolverDNSSECValidationStatus_DISABLING,
+		svcapitypes.ResolverDNSSECValidationStatus_UPDATING_TO_USE_LOCAL_RESOURCE_SETTING:
+		return true
+	}
+	return false
+}
+
+// customDeleteResolverDNSSECConfig hands DNSSEC validation for the VPC back
+// to USE_LOCAL_RESOURCE_SETTING. A DNSSEC configuration cannot be deleted, so
+// removing the CR only gives up management of it. A VPC that no longer exists
+// has nothing left to reset.
+func (rm *resourceManager) customDeleteResolverDNSSECConfig(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customDeleteResolverDNSSECConfig")
+	defer func() {
+		exit(err)
+	}()
+
+	if r.ko.Spec.VPCID == nil {
+		return nil, nil
+	}
+	if isTransitioning(r) {
+		return r, RequeueWhileTransitioning
+	}
+	input := &svcsdk.UpdateResolverDnssecConfigInput{
+		ResourceId: r.ko.Spec.VPCID,
+		Validation: svcsdktypes.ValidationUseLocalResourceSetting,
+	}
+	_, err = rm.sdkapi.UpdateResolverDnssecConfig(ctx, input)
+	rm.metrics.RecordAPICall("DELETE", "UpdateResolverDnssecConfig", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceNotFoundException" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
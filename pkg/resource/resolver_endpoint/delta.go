@@ -41,6 +41,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	customPreCompare(delta, a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.Direction, b.ko.Spec.Direction) {
 		delta.Add("Spec.Direction", a.ko.Spec.Direction, b.ko.Spec.Direction)
@@ -49,13 +50,6 @@ func newResourceDelta(
 			delta.Add("Spec.Direction", a.ko.Spec.Direction, b.ko.Spec.Direction)
 		}
 	}
-	if len(a.ko.Spec.IPAddresses) != len(b.ko.Spec.IPAddresses) {
-		delta.Add("Spec.IPAddresses", a.ko.Spec.IPAddresses, b.ko.Spec.IPAddresses)
-	} else if len(a.ko.Spec.IPAddresses) > 0 {
-		if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.IPAddresses, b.ko.Spec.IPAddresses) {
-			delta.Add("Spec.IPAddresses", a.ko.Spec.IPAddresses, b.ko.Spec.IPAddresses)
-		}
-	}
 	if ackcompare.HasNilDifference(a.ko.Spec.Name, b.ko.Spec.Name) {
 		delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 	} else if a.ko.Spec.Name != nil && b.ko.Spec.Name != nil {
@@ -63,6 +57,20 @@ func newResourceDelta(
 			delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.OutpostARN, b.ko.Spec.OutpostARN) {
+		delta.Add("Spec.OutpostARN", a.ko.Spec.OutpostARN, b.ko.Spec.OutpostARN)
+	} else if a.ko.Spec.OutpostARN != nil && b.ko.Spec.OutpostARN != nil {
+		if *a.ko.Spec.OutpostARN != *b.ko.Spec.OutpostARN {
+			delta.Add("Spec.OutpostARN", a.ko.Spec.OutpostARN, b.ko.Spec.OutpostARN)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.PreferredInstanceType, b.ko.Spec.PreferredInstanceType) {
+		delta.Add("Spec.PreferredInstanceType", a.ko.Spec.PreferredInstanceType, b.ko.Spec.PreferredInstanceType)
+	} else if a.ko.Spec.PreferredInstanceType != nil && b.ko.Spec.PreferredInstanceType != nil {
+		if *a.ko.Spec.PreferredInstanceType != *b.ko.Spec.PreferredInstanceType {
+			delta.Add("Spec.PreferredInstanceType", a.ko.Spec.PreferredInstanceType, b.ko.Spec.PreferredInstanceType)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.ResolverEndpointType, b.ko.Spec.ResolverEndpointType) {
 		delta.Add("Spec.ResolverEndpointType", a.ko.Spec.ResolverEndpointType, b.ko.Spec.ResolverEndpointType)
 	} else if a.ko.Spec.ResolverEndpointType != nil && b.ko.Spec.ResolverEndpointType != nil {
@@ -2,25 +2,441 @@ package resolver_endpoint
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
 	"time"
 
 	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/adoption"
 	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/tags"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionTypeIPAddressesHealthy is the type of the condition that rolls up
+// the status of every IP address attached to the endpoint.
+const ConditionTypeIPAddressesHealthy ackv1alpha1.ConditionType = "IPAddressesHealthy"
+
+const (
+	ipAddressesReasonAttached = "Attached"
+	ipAddressesReasonPending  = "Pending"
+	ipAddressesReasonFailed   = "Failed"
+	ipAddressesReasonMissing  = "Missing"
+
+	ipAddressesReasonActionNeeded = "ActionNeeded"
+
+	ipAddressesReasonRemediating = "Remediating"
+
+	endpointReasonMigrating = "Migrating"
+)
+
+var (
+	RequeueWhileUpdating = requeue.NeededAfter(
+		errors.New("resolver endpoint is updating"),
+		requeue.DefaultRequeueAfterDuration,
+	)
+	RequeueToPersistCreatorRequestId = requeue.Needed(
+		errors.New("requeuing to persist the creator request ID before creating the resolver endpoint"),
+	)
+)
+
+const (
+	// remediationReplacementNeeded is the remediation step of a failed IP
+	// address that sdkFind recorded and that still needs a replacement.
+	remediationReplacementNeeded = "ReplacementNeeded"
+	// remediationReplacementRequested is the remediation step of a failed IP
+	// address for which a replacement IP address has been associated.
+	remediationReplacementRequested = "ReplacementRequested"
+	// remediationDisassociating is the remediation step of a failed IP
+	// address that is being disassociated once its replacement is attached.
+	remediationDisassociating = "Disassociating"
+
+	// maxRemediationSteps is the number of remediation steps kept in status.
+	maxRemediationSteps = 20
 )
 
 // getCreatorRequestId will generate a CreatorRequestId for a given resolver endpoint
-// using the name of the endpoint and the current timestamp, so that it produces a
-// unique value
+// from the UID and generation of the object, so that every create attempt for
+// the same generation uses the same value
 func getCreatorRequestId(endpoint *svcapitypes.ResolverEndpoint) *string {
-	requestId := fmt.Sprintf("%s-%d", *endpoint.Spec.Name, time.Now().UnixMilli())
+	requestId := fmt.Sprintf("%s-%d", endpoint.UID, endpoint.Generation)
 	return &requestId
 }
 
+// ensureCreatorRequestId stores the CreatorRequestId of the next create call
+// in status. When the stored value changes it returns
+// RequeueToPersistCreatorRequestId, so that the value is saved to the cluster
+// before the endpoint is created and a retried create can find it.
+func ensureCreatorRequestId(r *resource) error {
+	requestId := getCreatorRequestId(r.ko)
+	if aws.ToString(r.ko.Status.CreatorRequestID) == *requestId {
+		return nil
+	}
+	r.ko.Status.CreatorRequestID = requestId
+	return RequeueToPersistCreatorRequestId
+}
+
+// findByCreatorRequestId looks up the endpoint created with the
+// CreatorRequestId stored in status. It returns a copy of the resource with
+// the ID of that endpoint, so that an endpoint created by an earlier attempt
+// is adopted instead of created again, or the resource itself if there is
+// none.
+func (rm *resourceManager) findByCreatorRequestId(
+	ctx context.Context,
+	r *resource,
+) (found *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.findByCreatorRequestId")
+	defer exit(err)
+
+	resp, err := rm.sdkapi.ListResolverEndpoints(
+		ctx,
+		&svcsdk.ListResolverEndpointsInput{
+			Filters: []svcsdktypes.Filter{
+				{
+					Name:   aws.String("CreatorRequestId"),
+					Values: []string{*r.ko.Status.CreatorRequestID},
+				},
+			},
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "ListResolverEndpoints", err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.ResolverEndpoints) == 0 {
+		return r, nil
+	}
+	found = &resource{r.ko.DeepCopy()}
+	found.ko.Status.ID = resp.ResolverEndpoints[0].Id
+	return found, nil
+}
+
+// customPreCompare compares the fields of the endpoint that the generated
+// delta cannot. Protocols and IP addresses are compared as sets, since Route
+// 53 Resolver does not keep the order they were set in. An IP address that
+// only names a subnet matches any attached address in that subnet. A failed
+// IP address waiting for its next remediation step is a difference too, so
+// that the update moves the remediation forward.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	added, unmatched := matchIPAddresses(a.ko.Spec.IPAddresses, b.ko.Spec.IPAddresses)
+	if len(added) > 0 || len(unmatched) > 0 {
+		delta.Add("Spec.IPAddresses", a.ko.Spec.IPAddresses, b.ko.Spec.IPAddresses)
+	}
+	if needsRemediation(b.ko) {
+		delta.Add("Status.IPAddressRemediations", a.ko.Status.IPAddressRemediations, b.ko.Status.IPAddressRemediations)
+	}
+	if !equalStringSets(a.ko.Spec.Protocols, b.ko.Spec.Protocols) {
+		delta.Add("Spec.Protocols", a.ko.Spec.Protocols, b.ko.Spec.Protocols)
+	}
+}
+
+// equalStringSets returns true when both slices hold the same values,
+// regardless of order and duplicates.
+func equalStringSets(a, b []*string) bool {
+	setA := lo.Uniq(aws.ToStringSlice(a))
+	setB := lo.Uniq(aws.ToStringSlice(b))
+	return len(setA) == len(setB) && lo.Every(setA, setB)
+}
+
+// validateProtocols returns a terminal error for protocol combinations that
+// Route 53 Resolver rejects: DoH-FIPS on an outbound endpoint, and DoH
+// together with DoH-FIPS.
+func validateProtocols(r *resource) error {
+	protocols := map[svcapitypes.Protocol]bool{}
+	for _, p := range r.ko.Spec.Protocols {
+		if p != nil {
+			protocols[svcapitypes.Protocol(*p)] = true
+		}
+	}
+	if !protocols[svcapitypes.Protocol_DoH_FIPS] {
+		return nil
+	}
+	if r.ko.Spec.Direction != nil &&
+		*r.ko.Spec.Direction == string(svcapitypes.ResolverEndpointDirection_OUTBOUND) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"protocol %s is only supported on inbound endpoints", svcapitypes.Protocol_DoH_FIPS,
+		))
+	}
+	if protocols[svcapitypes.Protocol_DoH] {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"protocols %s and %s cannot be combined", svcapitypes.Protocol_DoH, svcapitypes.Protocol_DoH_FIPS,
+		))
+	}
+	return nil
+}
+
+// validateOutpost returns a terminal error when only one of spec.outpostARN
+// and spec.preferredInstanceType is set. Route 53 Resolver requires both to
+// create an endpoint on an Outpost.
+func validateOutpost(r *resource) error {
+	if (r.ko.Spec.OutpostARN == nil) != (r.ko.Spec.PreferredInstanceType == nil) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"outpostARN and preferredInstanceType must be set together",
+		))
+	}
+	return nil
+}
+
+// validateEndpointTypeChange returns a terminal error when the endpoint type
+// is changed to IPV6. Route 53 Resolver only migrates an existing endpoint
+// from IPV4 to DUALSTACK.
+func validateEndpointTypeChange(desired, latest *resource) error {
+	if aws.ToString(desired.ko.Spec.ResolverEndpointType) != string(svcapitypes.ResolverEndpointType_IPV6) {
+		return nil
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"resolverEndpointType cannot be changed from %s to %s, change it to %s instead",
+		aws.ToString(latest.ko.Spec.ResolverEndpointType),
+		svcapitypes.ResolverEndpointType_IPV6,
+		svcapitypes.ResolverEndpointType_DUALSTACK,
+	))
+}
+
+// isDualStackMigration returns true when the endpoint type changes from IPV4
+// to DUALSTACK.
+func isDualStackMigration(desired, latest *resource) bool {
+	return aws.ToString(latest.ko.Spec.ResolverEndpointType) == string(svcapitypes.ResolverEndpointType_IPV4) &&
+		aws.ToString(desired.ko.Spec.ResolverEndpointType) == string(svcapitypes.ResolverEndpointType_DUALSTACK)
+}
+
+// isUpdating returns true while Route 53 Resolver is updating the endpoint.
+func isUpdating(r *resource) bool {
+	return aws.ToString(r.ko.Status.Status) == string(svcapitypes.ResolverEndpointStatus_SDK_UPDATING)
+}
+
+// newDualStackIPAddresses returns the IPv6 address to assign to every
+// attached IP address when the endpoint migrates to DUALSTACK. An IPv6
+// address pinned in spec.ipAddresses next to the same IPv4 address is used
+// as is. Otherwise the first free address of the IPv6 CIDR block of the
+// subnet, starting from the one ending in the last two bytes of the IPv4
+// address, is used. Every subnet must have an IPv6 CIDR block.
+func (rm *resourceManager) newDualStackIPAddresses(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updates []svcsdktypes.UpdateIpAddress, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.newDualStackIPAddresses")
+	defer exit(err)
+
+	subnetIDs := []string{}
+	for _, ipa := range latest.ko.Status.IPAddresses {
+		if ipa.SubnetID != nil && !lo.Contains(subnetIDs, *ipa.SubnetID) {
+			subnetIDs = append(subnetIDs, *ipa.SubnetID)
+		}
+	}
+	cidrs, err := rm.getSubnetIPv6CIDRs(ctx, subnetIDs)
+	if err != nil {
+		return nil, err
+	}
+	missing := lo.Filter(subnetIDs, func(id string, _ int) bool {
+		_, found := cidrs[id]
+		return !found
+	})
+	if len(missing) > 0 {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"cannot migrate to %s, subnets %s have no IPv6 CIDR block",
+			svcapitypes.ResolverEndpointType_DUALSTACK, strings.Join(missing, ", "),
+		))
+	}
+
+	used, err := rm.getSubnetIPv6AddressesInUse(ctx, subnetIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, req := range desired.ko.Spec.IPAddresses {
+		if addr, err := netip.ParseAddr(aws.ToString(req.IPv6)); err == nil {
+			used[addr] = true
+		}
+	}
+	for _, ipa := range latest.ko.Status.IPAddresses {
+		if ipa.IPv6 != nil {
+			continue
+		}
+		ipv6 := pinnedIPv6(ipa, desired.ko.Spec.IPAddresses)
+		if ipv6 == nil {
+			if ipv6, err = freeIPv6(cidrs[aws.ToString(ipa.SubnetID)], aws.ToString(ipa.IP), used); err != nil {
+				return nil, err
+			}
+		}
+		updates = append(updates, svcsdktypes.UpdateIpAddress{
+			IpId: ipa.IPID,
+			Ipv6: ipv6,
+		})
+	}
+	return updates, nil
+}
+
+// getSubnetIPv6CIDRs returns the associated IPv6 CIDR block of each of the
+// supplied subnets that has one.
+func (rm *resourceManager) getSubnetIPv6CIDRs(
+	ctx context.Context,
+	subnetIDs []string,
+) (cidrs map[string]string, err error) {
+	cidrs = map[string]string{}
+	if len(subnetIDs) == 0 {
+		return cidrs, nil
+	}
+	resp, err := ec2.NewFromConfig(rm.clientcfg).DescribeSubnets(
+		ctx,
+		&ec2.DescribeSubnetsInput{
+			SubnetIds: subnetIDs,
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeSubnets", err)
+	if err != nil {
+		return nil, err
+	}
+	for _, subnet := range resp.Subnets {
+		for _, assoc := range subnet.Ipv6CidrBlockAssociationSet {
+			if assoc.Ipv6CidrBlockState != nil &&
+				assoc.Ipv6CidrBlockState.State == ec2types.SubnetCidrBlockStateCodeAssociated {
+				cidrs[aws.ToString(subnet.SubnetId)] = aws.ToString(assoc.Ipv6CidrBlock)
+				break
+			}
+		}
+	}
+	return cidrs, nil
+}
+
+// getSubnetIPv6AddressesInUse returns the IPv6 addresses assigned to the
+// network interfaces of the supplied subnets.
+func (rm *resourceManager) getSubnetIPv6AddressesInUse(
+	ctx context.Context,
+	subnetIDs []string,
+) (used map[netip.Addr]bool, err error) {
+	used = map[netip.Addr]bool{}
+	if len(subnetIDs) == 0 {
+		return used, nil
+	}
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(
+		ec2.NewFromConfig(rm.clientcfg),
+		&ec2.DescribeNetworkInterfacesInput{
+			Filters: []ec2types.Filter{{
+				Name:   aws.String("subnet-id"),
+				Values: subnetIDs,
+			}},
+		},
+	)
+	for paginator.HasMorePages() {
+		resp, err := paginator.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeNetworkInterfaces", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, eni := range resp.NetworkInterfaces {
+			for _, assigned := range eni.Ipv6Addresses {
+				if addr, err := netip.ParseAddr(aws.ToString(assigned.Ipv6Address)); err == nil {
+					used[addr] = true
+				}
+			}
+		}
+	}
+	return used, nil
+}
+
+// pinnedIPv6 returns the IPv6 address pinned in spec.ipAddresses for the
+// attached IP address, or nil if there is none.
+func pinnedIPv6(
+	ipa *svcapitypes.IPAddressResponse,
+	desired []*svcapitypes.IPAddressRequest,
+) *string {
+	for _, req := range desired {
+		if req.IPv6 != nil &&
+			aws.ToString(req.SubnetID) == aws.ToString(ipa.SubnetID) &&
+			aws.ToString(req.IP) == aws.ToString(ipa.IP) {
+			return req.IPv6
+		}
+	}
+	return nil
+}
+
+// reservedSubnetIPv6Addresses is the number of addresses AWS reserves at the
+// start of the IPv6 CIDR block of a subnet.
+const reservedSubnetIPv6Addresses = 4
+
+// freeIPv6 returns the first address of the IPv6 CIDR block that is neither
+// reserved nor in use, starting from the one whose last two bytes are the
+// last two bytes of the IPv4 address, and marks it as used.
+func freeIPv6(cidr string, ipv4 string, used map[netip.Addr]bool) (*string, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, err
+	}
+	prefix = prefix.Masked()
+	v4, err := netip.ParseAddr(ipv4)
+	if err != nil {
+		return nil, err
+	}
+	v4b := v4.As4()
+	v6b := prefix.Addr().As16()
+	v6b[14], v6b[15] = v4b[2], v4b[3]
+
+	firstUsable := prefix.Addr()
+	for i := 0; i < reservedSubnetIPv6Addresses; i++ {
+		firstUsable = firstUsable.Next()
+	}
+	for addr := netip.AddrFrom16(v6b); prefix.Contains(addr); addr = addr.Next() {
+		if addr.Less(firstUsable) || used[addr] {
+			continue
+		}
+		used[addr] = true
+		s := addr.String()
+		return &s, nil
+	}
+	return nil, ackerr.NewTerminalError(fmt.Errorf(
+		"no free IPv6 address left in %s for %s", cidr, ipv4,
+	))
+}
+
+// setDualStackMigrationCondition marks the endpoint as not synced while it is
+// migrating to DUALSTACK, with the number of IP addresses that already have
+// an IPv6 address.
+func setDualStackMigrationCondition(
+	ko *svcapitypes.ResolverEndpoint,
+	desiredType *string,
+) {
+	if aws.ToString(desiredType) != string(svcapitypes.ResolverEndpointType_DUALSTACK) ||
+		aws.ToString(ko.Status.Status) != string(svcapitypes.ResolverEndpointStatus_SDK_UPDATING) {
+		return
+	}
+	withIPv6 := 0
+	for _, ipa := range ko.Status.IPAddresses {
+		if ipa.IPv6 != nil {
+			withIPv6++
+		}
+	}
+	if withIPv6 == len(ko.Status.IPAddresses) {
+		return
+	}
+	msg := fmt.Sprintf(
+		"migrating to %s: %d of %d IP addresses have an IPv6 address",
+		svcapitypes.ResolverEndpointType_DUALSTACK, withIPv6, len(ko.Status.IPAddresses),
+	)
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, aws.String(endpointReasonMigrating))
+}
+
 func (rm *resourceManager) ListAttachedIPAddresses(
 	ctx context.Context,
 	resource *svcapitypes.ResolverEndpoint,
@@ -73,6 +489,15 @@ func (rm *resourceManager) ListAttachedIPAddresses(
 			if elem.IpId != nil {
 				f3.IPID = elem.IpId
 			}
+			if elem.Ip != nil {
+				f3.IP = elem.Ip
+			}
+			if elem.Ipv6 != nil {
+				f3.IPv6 = elem.Ipv6
+			}
+			if elem.SubnetId != nil {
+				f3.SubnetID = elem.SubnetId
+			}
 			f0 = append(f0, f1)
 			f2 = append(f2, f3)
 		}
@@ -87,6 +512,10 @@ func (rm *resourceManager) ListAttachedIPAddresses(
 	return err
 }
 
+// SyncIPAddresses associates the desired IP addresses that are missing from
+// the endpoint and then disassociates the ones that are no longer desired.
+// Adding first keeps the endpoint above the two IP addresses Route 53 Resolver
+// requires while an address is being replaced.
 func (rm *resourceManager) SyncIPAddresses(
 	ctx context.Context,
 	desired *resource,
@@ -143,38 +572,529 @@ func (rm *resourceManager) SyncIPAddresses(
 	return err
 }
 
+// GetIPAddressDifference returns the desired IP addresses that are not
+// attached to the endpoint, and the IDs of the attached IP addresses that are
+// not desired. An IP address is identified by its subnet together with the
+// IPv4 and IPv6 addresses it pins, so a subnet can hold several addresses and
+// changing a pinned address replaces it.
 func (rm *resourceManager) GetIPAddressDifference(
 	desired, latest *resource,
 ) (added []*svcapitypes.IPAddressRequest, removed []*string) {
+	added, unmatched := matchIPAddresses(desired.ko.Spec.IPAddresses, latest.ko.Spec.IPAddresses)
+	for _, ipa := range unmatched {
+		if ipID := findIPID(ipa, latest.ko.Status.IPAddresses); ipID != nil {
+			removed = append(removed, ipID)
+		}
+	}
+	return added, removed
+}
+
+// matchIPAddresses pairs every desired IP address with an attached one that
+// satisfies it, regardless of order. It returns the desired IP addresses left
+// without an attached address, and the attached IP addresses no desired one
+// claimed.
+func matchIPAddresses(
+	desired []*svcapitypes.IPAddressRequest,
+	attached []*svcapitypes.IPAddressRequest,
+) (added []*svcapitypes.IPAddressRequest, unmatched []*svcapitypes.IPAddressRequest) {
+	matched := make([]bool, len(attached))
 
-	for _, ipa := range desired.ko.Spec.IPAddresses {
-		if !inIpAddress(*ipa.SubnetID, latest.ko.Spec.IPAddresses) {
+	// Pinned addresses are matched first, so that an entry that only names a
+	// subnet cannot claim the address a pinned entry asks for.
+	unpinned := []*svcapitypes.IPAddressRequest{}
+	for _, ipa := range desired {
+		if ipa.IP == nil && ipa.IPv6 == nil {
+			unpinned = append(unpinned, ipa)
+			continue
+		}
+		if !matchIPAddress(ipa, attached, matched) {
+			added = append(added, ipa)
+		}
+	}
+	for _, ipa := range unpinned {
+		if !matchIPAddress(ipa, attached, matched) {
 			added = append(added, ipa)
 		}
 	}
 
-	for i, ipa := range latest.ko.Spec.IPAddresses {
-		if !inIpAddress(*ipa.SubnetID, desired.ko.Spec.IPAddresses) {
-			removed = append(removed, latest.ko.Status.IPAddresses[i].IPID)
+	for i, ipa := range attached {
+		if !matched[i] {
+			unmatched = append(unmatched, ipa)
 		}
 	}
+	return added, unmatched
+}
 
-	return added, removed
+// matchIPAddress marks and returns true for the first attached IP address
+// that is not matched yet and satisfies the desired IP address.
+func matchIPAddress(
+	desired *svcapitypes.IPAddressRequest,
+	attached []*svcapitypes.IPAddressRequest,
+	matched []bool,
+) bool {
+	for i, ipa := range attached {
+		if !matched[i] && ipAddressSatisfies(ipa, desired) {
+			matched[i] = true
+			return true
+		}
+	}
+	return false
 }
 
-func inIpAddress(
-	subnetId string,
-	ipAddresses []*svcapitypes.IPAddressRequest,
+// ipAddressSatisfies returns true if the attached IP address is in the subnet
+// of the desired one and has every address the desired one pins.
+func ipAddressSatisfies(
+	attached *svcapitypes.IPAddressRequest,
+	desired *svcapitypes.IPAddressRequest,
 ) bool {
+	if aws.ToString(attached.SubnetID) != aws.ToString(desired.SubnetID) {
+		return false
+	}
+	if desired.IP != nil && aws.ToString(attached.IP) != *desired.IP {
+		return false
+	}
+	if desired.IPv6 != nil && aws.ToString(attached.IPv6) != *desired.IPv6 {
+		return false
+	}
+	return true
+}
+
+// findIPID returns the ID of the attached IP address with the same subnet and
+// addresses as the supplied one, or nil if there is none.
+func findIPID(
+	ipa *svcapitypes.IPAddressRequest,
+	attached []*svcapitypes.IPAddressResponse,
+) *string {
+	for _, resp := range attached {
+		if aws.ToString(resp.SubnetID) == aws.ToString(ipa.SubnetID) &&
+			aws.ToString(resp.IP) == aws.ToString(ipa.IP) &&
+			aws.ToString(resp.IPv6) == aws.ToString(ipa.IPv6) {
+			return resp.IPID
+		}
+	}
+	return nil
+}
+
+// isFailedIPAddressStatus returns true for the IP address statuses that
+// Route 53 Resolver does not recover from on its own.
+func isFailedIPAddressStatus(status string) bool {
+	switch svcapitypes.IPAddressStatus(status) {
+	case svcapitypes.IPAddressStatus_FAILED_CREATION,
+		svcapitypes.IPAddressStatus_FAILED_RESOURCE_GONE,
+		svcapitypes.IPAddressStatus_UPDATE_FAILED,
+		svcapitypes.IPAddressStatus_DELETE_FAILED_FAS_EXPIRED:
+		return true
+	}
+	return false
+}
+
+// isActionNeeded returns true when Route 53 Resolver reports that the
+// endpoint needs an operator action, such as replacing an IP address.
+func isActionNeeded(ko *svcapitypes.ResolverEndpoint) bool {
+	return aws.ToString(ko.Status.Status) == string(svcapitypes.ResolverEndpointStatus_SDK_ACTION_NEEDED)
+}
+
+// needsReplacement returns true when the IP address has to be replaced:
+// it failed, or the endpoint needs an action and the IP address is not
+// attached.
+func needsReplacement(ko *svcapitypes.ResolverEndpoint, ipa *svcapitypes.IPAddressResponse) bool {
+	status := aws.ToString(ipa.Status)
+	if isFailedIPAddressStatus(status) {
+		return true
+	}
+	return isActionNeeded(ko) && status != string(svcapitypes.IPAddressStatus_ATTACHED)
+}
+
+// describeIPAddress returns a short description of an attached IP address
+// and its status, for use in condition messages.
+func describeIPAddress(ipa *svcapitypes.IPAddressResponse) string {
+	addr := aws.ToString(ipa.IP)
+	if addr == "" {
+		addr = aws.ToString(ipa.IPv6)
+	}
+	desc := fmt.Sprintf(
+		"%s (%s in %s) is %s",
+		aws.ToString(ipa.IPID), addr, aws.ToString(ipa.SubnetID), aws.ToString(ipa.Status),
+	)
+	if ipa.StatusMessage != nil {
+		desc += ": " + *ipa.StatusMessage
+	}
+	return desc
+}
+
+// setIPAddressesCondition rolls the status of the attached IP addresses up
+// into the IPAddressesHealthy condition. Failed IP addresses, an endpoint
+// that needs an action, or fewer IP addresses than desired, also mark the
+// endpoint as not synced.
+func setIPAddressesCondition(
+	ko *svcapitypes.ResolverEndpoint,
+	desired []*svcapitypes.IPAddressRequest,
+) {
+	failed := []string{}
+	pending := []string{}
+	for _, ipa := range ko.Status.IPAddresses {
+		status := aws.ToString(ipa.Status)
+		if status == string(svcapitypes.IPAddressStatus_ATTACHED) {
+			continue
+		}
+		if isFailedIPAddressStatus(status) {
+			failed = append(failed, describeIPAddress(ipa))
+		} else {
+			pending = append(pending, describeIPAddress(ipa))
+		}
+	}
+
+	total := len(ko.Status.IPAddresses)
+	switch {
+	case len(failed) > 0:
+		msg := fmt.Sprintf(
+			"%d of %d IP addresses failed: %s",
+			len(failed), total, strings.Join(failed, "; "),
+		)
+		setIPAddressesHealthy(ko, corev1.ConditionFalse, ipAddressesReasonFailed, msg)
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, aws.String(ipAddressesReasonFailed))
+	case isActionNeeded(ko):
+		msg := "the endpoint needs an action"
+		if ko.Status.StatusMessage != nil {
+			msg += ": " + *ko.Status.StatusMessage
+		}
+		if len(pending) > 0 {
+			msg += "; " + strings.Join(pending, "; ")
+		}
+		setIPAddressesHealthy(ko, corev1.ConditionFalse, ipAddressesReasonActionNeeded, msg)
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, aws.String(ipAddressesReasonActionNeeded))
+	case total < len(desired):
+		msg := fmt.Sprintf(
+			"%d IP addresses are attached, %d are desired", total, len(desired),
+		)
+		setIPAddressesHealthy(ko, corev1.ConditionFalse, ipAddressesReasonMissing, msg)
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, aws.String(ipAddressesReasonMissing))
+	case len(pending) > 0:
+		msg := strings.Join(pending, "; ")
+		setIPAddressesHealthy(ko, corev1.ConditionUnknown, ipAddressesReasonPending, msg)
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, aws.String(ipAddressesReasonPending))
+	default:
+		setIPAddressesHealthy(
+			ko, corev1.ConditionTrue, ipAddressesReasonAttached,
+			fmt.Sprintf("%d IP addresses are attached", total),
+		)
+	}
+}
+
+// setIPAddressesPending marks the IPAddressesHealthy condition as unknown
+// and the endpoint as not synced while an update is associating or
+// disassociating IP addresses.
+func setIPAddressesPending(ko *svcapitypes.ResolverEndpoint) {
+	msg := "IP addresses are being associated or disassociated"
+	setIPAddressesHealthy(ko, corev1.ConditionUnknown, ipAddressesReasonPending, msg)
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, aws.String(ipAddressesReasonPending))
+}
+
+// copyIPAddressStatus copies the attached IP addresses, the remediation state
+// and the conditions sdkFind derived from them from the latest endpoint onto
+// the desired one. An update starts from a copy of desired, whose conditions
+// are reset at the start of every reconciliation, so without this the
+// IPAddressesHealthy condition and the reason the endpoint is not synced
+// would be lost.
+func copyIPAddressStatus(desired, latest *svcapitypes.ResolverEndpoint) {
+	desired.Status.IPAddresses = latest.Status.IPAddresses
+	desired.Status.IPAddressRemediations = latest.Status.IPAddressRemediations
+	desired.Status.RemediationSteps = latest.Status.RemediationSteps
+	for _, cond := range latest.Status.Conditions {
+		if cond.Type != ConditionTypeIPAddressesHealthy &&
+			cond.Type != ackv1alpha1.ConditionTypeResourceSynced {
+			continue
+		}
+		copied := cond.DeepCopy()
+		_, idx, found := lo.FindIndexOf(desired.Status.Conditions, func(c *ackv1alpha1.Condition) bool {
+			return c.Type == cond.Type
+		})
+		if found {
+			desired.Status.Conditions[idx] = copied
+		} else {
+			desired.Status.Conditions = append(desired.Status.Conditions, copied)
+		}
+	}
+}
+
+// setIPAddressesHealthy sets the IPAddressesHealthy condition to the supplied
+// status, reason and message.
+func setIPAddressesHealthy(
+	ko *svcapitypes.ResolverEndpoint,
+	status corev1.ConditionStatus,
+	reason string,
+	message string,
+) {
+	var c *ackv1alpha1.Condition
+	for _, cond := range ko.Status.Conditions {
+		if cond.Type == ConditionTypeIPAddressesHealthy {
+			c = cond
+			break
+		}
+	}
+	if c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: ConditionTypeIPAddressesHealthy,
+		}
+		ko.Status.Conditions = append(ko.Status.Conditions, c)
+	}
+	if c.Status != status {
+		now := metav1.Now()
+		c.LastTransitionTime = &now
+	}
+	c.Status = status
+	c.Reason = &reason
+	c.Message = &message
+}
+
+// recordFailedIPAddresses records the IP addresses of an endpoint that need
+// a replacement in status when spec.autoRemediation is enabled, and forgets
+// the ones that are gone. Besides failed IP addresses, an endpoint in
+// ACTION_NEEDED has every IP address that is not attached replaced. It only keeps track of the remediation: the replacements are made by
+// remediateIPAddresses during an update. The remediation stops when auto
+// remediation is disabled, or when the endpoint is read-only or being
+// deleted, since no update runs then.
+func recordFailedIPAddresses(
+	desired *resource,
+	ko *svcapitypes.ResolverEndpoint,
+) {
+	if !canRemediate(desired) || !aws.ToBool(desired.ko.Spec.AutoRemediation) {
+		if len(ko.Status.IPAddressRemediations) > 0 {
+			addRemediationStep(ko, "auto remediation stopped")
+			ko.Status.IPAddressRemediations = nil
+		}
+		return
+	}
+	switch aws.ToString(ko.Status.Status) {
+	case string(svcapitypes.ResolverEndpointStatus_SDK_CREATING),
+		string(svcapitypes.ResolverEndpointStatus_SDK_DELETING):
+		return
+	}
+
+	attached := map[string]*svcapitypes.IPAddressResponse{}
+	for _, ipa := range ko.Status.IPAddresses {
+		attached[aws.ToString(ipa.IPID)] = ipa
+	}
+	for ipID := range ko.Status.IPAddressRemediations {
+		if _, found := attached[ipID]; !found {
+			addRemediationStep(ko, fmt.Sprintf("%s disassociated", ipID))
+			delete(ko.Status.IPAddressRemediations, ipID)
+		}
+	}
+	for _, ipa := range ko.Status.IPAddresses {
+		ipID := aws.ToString(ipa.IPID)
+		if !needsReplacement(ko, ipa) {
+			continue
+		}
+		if _, found := ko.Status.IPAddressRemediations[ipID]; found {
+			continue
+		}
+		if ko.Status.IPAddressRemediations == nil {
+			ko.Status.IPAddressRemediations = map[string]*string{}
+		}
+		ko.Status.IPAddressRemediations[ipID] = aws.String(remediationReplacementNeeded)
+		addRemediationStep(ko, fmt.Sprintf("%s, replacement needed", describeIPAddress(ipa)))
+	}
+}
 
-	for _, ipa := range ipAddresses {
-		if *ipa.SubnetID == subnetId {
+// canRemediate returns false when the endpoint is read-only or being
+// deleted, in which case its IP addresses are left as they are.
+func canRemediate(r *resource) bool {
+	return !r.IsBeingDeleted() && !ackrt.IsReadOnly(r)
+}
+
+// needsRemediation returns true when a recorded failed IP address of the
+// endpoint is waiting for the next remediation step: a replacement to be
+// associated, or, once no IP address is pending anymore, the failed IP
+// address to be disassociated.
+func needsRemediation(ko *svcapitypes.ResolverEndpoint) bool {
+	pending := hasPendingIPAddresses(ko)
+	for _, step := range ko.Status.IPAddressRemediations {
+		switch aws.ToString(step) {
+		case remediationReplacementNeeded:
 			return true
+		case remediationReplacementRequested:
+			if !pending {
+				return true
+			}
 		}
 	}
 	return false
 }
 
+// hasPendingIPAddresses returns true while an IP address of the endpoint is
+// neither attached, failed nor being replaced.
+func hasPendingIPAddresses(ko *svcapitypes.ResolverEndpoint) bool {
+	return lo.SomeBy(ko.Status.IPAddresses, func(ipa *svcapitypes.IPAddressResponse) bool {
+		if _, replacing := ko.Status.IPAddressRemediations[aws.ToString(ipa.IPID)]; replacing {
+			return false
+		}
+		status := aws.ToString(ipa.Status)
+		return status != string(svcapitypes.IPAddressStatus_ATTACHED) && !isFailedIPAddressStatus(status)
+	})
+}
+
+// remediateIPAddresses moves every failed IP address recorded by
+// recordFailedIPAddresses one step forward. A fresh IP address is associated
+// in the subnet of each failed IP address, and the failed one is
+// disassociated once no IP address is pending anymore. The progress is kept
+// in the status of desired, which holds the remediation state of latest.
+func (rm *resourceManager) remediateIPAddresses(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.remediateIPAddresses")
+	defer exit(err)
+
+	ko := desired.ko
+	attached := map[string]*svcapitypes.IPAddressResponse{}
+	for _, ipa := range latest.ko.Status.IPAddresses {
+		attached[aws.ToString(ipa.IPID)] = ipa
+	}
+	pending := hasPendingIPAddresses(latest.ko)
+
+	ipIDs := lo.Keys(ko.Status.IPAddressRemediations)
+	sort.Strings(ipIDs)
+	for _, ipID := range ipIDs {
+		ipa, found := attached[ipID]
+		if !found {
+			continue
+		}
+		switch aws.ToString(ko.Status.IPAddressRemediations[ipID]) {
+		case remediationReplacementNeeded:
+			ipAddresses := append([]*svcapitypes.IPAddressRequest{}, latest.ko.Spec.IPAddresses...)
+			ipAddresses = append(ipAddresses, &svcapitypes.IPAddressRequest{
+				SubnetID: ipa.SubnetID,
+			})
+			if err = rm.syncRemediatedIPAddresses(ctx, ipAddresses, latest); err != nil {
+				return err
+			}
+			ko.Status.IPAddressRemediations[ipID] = aws.String(remediationReplacementRequested)
+			addRemediationStep(ko, fmt.Sprintf(
+				"associating a replacement for %s in %s",
+				ipID, aws.ToString(ipa.SubnetID),
+			))
+			pending = true
+		case remediationReplacementRequested:
+			if pending {
+				continue
+			}
+			ipAddresses := []*svcapitypes.IPAddressRequest{}
+			for _, req := range latest.ko.Spec.IPAddresses {
+				if aws.ToString(findIPID(req, latest.ko.Status.IPAddresses)) != ipID {
+					ipAddresses = append(ipAddresses, req)
+				}
+			}
+			if err = rm.syncRemediatedIPAddresses(ctx, ipAddresses, latest); err != nil {
+				return err
+			}
+			ko.Status.IPAddressRemediations[ipID] = aws.String(remediationDisassociating)
+			addRemediationStep(ko, fmt.Sprintf(
+				"replacement attached in %s, disassociating %s",
+				aws.ToString(ipa.SubnetID), ipID,
+			))
+		}
+	}
+	return nil
+}
+
+// syncRemediatedIPAddresses brings the IP addresses attached to the endpoint
+// in line with the supplied ones, using the same machinery as spec updates.
+func (rm *resourceManager) syncRemediatedIPAddresses(
+	ctx context.Context,
+	ipAddresses []*svcapitypes.IPAddressRequest,
+	latest *resource,
+) error {
+	desired := &resource{latest.ko.DeepCopy()}
+	desired.ko.Spec.IPAddresses = ipAddresses
+	return rm.SyncIPAddresses(ctx, desired, latest)
+}
+
+// isRemediating returns true while failed IP addresses of the endpoint are
+// being replaced.
+func isRemediating(ko *svcapitypes.ResolverEndpoint) bool {
+	return len(ko.Status.IPAddressRemediations) > 0
+}
+
+// addRemediationStep records a timestamped remediation step in status,
+// keeping only the most recent ones.
+func addRemediationStep(ko *svcapitypes.ResolverEndpoint, step string) {
+	entry := fmt.Sprintf("%s: %s", time.Now().UTC().Format(time.RFC3339), step)
+	ko.Status.RemediationSteps = append(ko.Status.RemediationSteps, &entry)
+	if n := len(ko.Status.RemediationSteps); n > maxRemediationSteps {
+		ko.Status.RemediationSteps = ko.Status.RemediationSteps[n-maxRemediationSteps:]
+	}
+}
+
+// setRemediationCondition marks the endpoint as not synced while failed IP
+// addresses are being replaced, so that the remediation keeps progressing.
+func setRemediationCondition(ko *svcapitypes.ResolverEndpoint) {
+	if !isRemediating(ko) {
+		return
+	}
+	ipIDs := make([]string, 0, len(ko.Status.IPAddressRemediations))
+	for ipID, step := range ko.Status.IPAddressRemediations {
+		ipIDs = append(ipIDs, fmt.Sprintf("%s (%s)", ipID, aws.ToString(step)))
+	}
+	sort.Strings(ipIDs)
+	msg := fmt.Sprintf("remediating failed IP addresses: %s", strings.Join(ipIDs, ", "))
+	setIPAddressesHealthy(ko, corev1.ConditionFalse, ipAddressesReasonRemediating, msg)
+	ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, aws.String(ipAddressesReasonRemediating))
+}
+
+// findByAdoptionFilters looks up the endpoint to adopt with spec.adoptionFilters
+// when the resource is being adopted and has no ID yet. It returns a copy of
+// the resource with the ID of the only matching endpoint, and an error
+// when none or several match.
+func (rm *resourceManager) findByAdoptionFilters(
+	ctx context.Context,
+	r *resource,
+) (found *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.findByAdoptionFilters")
+	defer exit(err)
+
+	if !adoption.NeedsLookup(r, r.ko.Status.ID, r.ko.Spec.AdoptionFilters) {
+		return r, nil
+	}
+	apiFilters, tagFilters := adoption.SplitFilters(r.ko.Spec.AdoptionFilters)
+	candidates := []adoption.Candidate{}
+	var nextToken *string
+	for {
+		resp, err := rm.sdkapi.ListResolverEndpoints(
+			ctx,
+			&svcsdk.ListResolverEndpointsInput{
+				Filters:   apiFilters,
+				NextToken: nextToken,
+			},
+		)
+		rm.metrics.RecordAPICall("READ_MANY", "ListResolverEndpoints", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, elem := range resp.ResolverEndpoints {
+			candidates = append(candidates, adoption.Candidate{
+				ID:  elem.Id,
+				ARN: elem.Arn,
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+
+	id, err := adoption.FindOne(ctx, rm.sdkapi, rm.metrics, candidates, tagFilters)
+	if err != nil {
+		return nil, err
+	}
+	found = &resource{r.ko.DeepCopy()}
+	found.ko.Status.ID = id
+	return found, nil
+}
+
 // getTags retrieves the resource's associated tags.
 func (rm *resourceManager) getTags(
 	ctx context.Context,
@@ -0,0 +1,273 @@
+package resolver_endpoint
+
+import (
+	"net/netip"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+)
+
+func newTestIPAddress(subnetID string, ip string) *svcapitypes.IPAddressRequest {
+	ipa := &svcapitypes.IPAddressRequest{SubnetID: lo.ToPtr(subnetID)}
+	if ip != "" {
+		ipa.IP = lo.ToPtr(ip)
+	}
+	return ipa
+}
+
+func newTestEndpoint(ipAddresses ...*svcapitypes.IPAddressRequest) *resource {
+	return &resource{&svcapitypes.ResolverEndpoint{
+		Spec: svcapitypes.ResolverEndpointSpec{
+			IPAddresses: ipAddresses,
+			Protocols:   []*string{lo.ToPtr("Do53"), lo.ToPtr("DoH")},
+		},
+	}}
+}
+
+func TestCustomPreCompare_IPAddresses(t *testing.T) {
+	attached := newTestEndpoint(
+		newTestIPAddress("subnet-a", "10.0.0.10"),
+		newTestIPAddress("subnet-b", "10.0.1.10"),
+	)
+	tests := []struct {
+		name    string
+		desired *resource
+		want    bool
+	}{
+		{
+			name: "same addresses in another order",
+			desired: newTestEndpoint(
+				newTestIPAddress("subnet-b", "10.0.1.10"),
+				newTestIPAddress("subnet-a", "10.0.0.10"),
+			),
+			want: false,
+		},
+		{
+			name: "unpinned addresses match any address in their subnet",
+			desired: newTestEndpoint(
+				newTestIPAddress("subnet-b", ""),
+				newTestIPAddress("subnet-a", ""),
+			),
+			want: false,
+		},
+		{
+			name: "pinned address changed",
+			desired: newTestEndpoint(
+				newTestIPAddress("subnet-a", "10.0.0.11"),
+				newTestIPAddress("subnet-b", "10.0.1.10"),
+			),
+			want: true,
+		},
+		{
+			name: "two unpinned addresses in a subnet holding one",
+			desired: newTestEndpoint(
+				newTestIPAddress("subnet-a", ""),
+				newTestIPAddress("subnet-a", ""),
+			),
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, tt.desired, attached)
+			if got := delta.DifferentAt("Spec.IPAddresses"); got != tt.want {
+				t.Errorf("expected a difference at Spec.IPAddresses to be %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCustomPreCompare_Protocols(t *testing.T) {
+	desired := newTestEndpoint()
+	latest := newTestEndpoint()
+	latest.ko.Spec.Protocols = []*string{lo.ToPtr("DoH"), lo.ToPtr("Do53")}
+
+	delta := ackcompare.NewDelta()
+	customPreCompare(delta, desired, latest)
+	if delta.DifferentAt("Spec.Protocols") {
+		t.Errorf("expected protocols in another order to be equal")
+	}
+}
+
+func newTestAttachedIPAddress(ipID, subnetID, ip, status string) *svcapitypes.IPAddressResponse {
+	return &svcapitypes.IPAddressResponse{
+		IPID:     lo.ToPtr(ipID),
+		SubnetID: lo.ToPtr(subnetID),
+		IP:       lo.ToPtr(ip),
+		Status:   lo.ToPtr(status),
+	}
+}
+
+func findCondition(
+	ko *svcapitypes.ResolverEndpoint,
+	condType ackv1alpha1.ConditionType,
+) *ackv1alpha1.Condition {
+	for _, cond := range ko.Status.Conditions {
+		if cond.Type == condType {
+			return cond
+		}
+	}
+	return nil
+}
+
+func TestCopyIPAddressStatus_HealthyEndpointIsSynced(t *testing.T) {
+	latest := newTestEndpoint(
+		newTestIPAddress("subnet-a", ""),
+		newTestIPAddress("subnet-b", ""),
+	)
+	latest.ko.Status.IPAddresses = []*svcapitypes.IPAddressResponse{
+		newTestAttachedIPAddress("rni-a", "subnet-a", "10.0.0.10", "ATTACHED"),
+		newTestAttachedIPAddress("rni-b", "subnet-b", "10.0.1.10", "ATTACHED"),
+	}
+	setIPAddressesCondition(latest.ko, latest.ko.Spec.IPAddresses)
+
+	// An update starts from desired, whose conditions were reset.
+	desired := newTestEndpoint(latest.ko.Spec.IPAddresses...)
+	copyIPAddressStatus(desired.ko, latest.ko)
+
+	healthy := findCondition(desired.ko, ConditionTypeIPAddressesHealthy)
+	if healthy == nil || healthy.Status != corev1.ConditionTrue {
+		t.Fatalf("expected the IPAddressesHealthy condition to be True, got %+v", healthy)
+	}
+	if lo.FromPtr(healthy.Reason) == ipAddressesReasonPending {
+		t.Errorf("expected no Pending condition on a healthy endpoint")
+	}
+	// Without a Synced condition set by the hooks, the runtime reports the
+	// endpoint as synced.
+	if synced := ackcondition.Synced(desired); synced != nil && synced.Status != corev1.ConditionTrue {
+		t.Errorf("expected the endpoint to be synced, got %+v", synced)
+	}
+}
+
+func TestCopyIPAddressStatus_FailedEndpointIsNotSynced(t *testing.T) {
+	latest := newTestEndpoint(
+		newTestIPAddress("subnet-a", ""),
+		newTestIPAddress("subnet-b", ""),
+	)
+	latest.ko.Status.IPAddresses = []*svcapitypes.IPAddressResponse{
+		newTestAttachedIPAddress("rni-a", "subnet-a", "10.0.0.10", "ATTACHED"),
+		newTestAttachedIPAddress("rni-b", "subnet-b", "10.0.1.10", "FAILED_RESOURCE_GONE"),
+	}
+	setIPAddressesCondition(latest.ko, latest.ko.Spec.IPAddresses)
+
+	desired := newTestEndpoint(latest.ko.Spec.IPAddresses...)
+	copyIPAddressStatus(desired.ko, latest.ko)
+
+	synced := ackcondition.Synced(desired)
+	if synced == nil || synced.Status != corev1.ConditionFalse ||
+		lo.FromPtr(synced.Reason) != ipAddressesReasonFailed {
+		t.Errorf("expected the endpoint not to be synced because of the failed IP address, got %+v", synced)
+	}
+}
+
+func TestSetIPAddressesPending(t *testing.T) {
+	ko := newTestEndpoint().ko
+	setIPAddressesPending(ko)
+
+	synced := ackcondition.Synced(&resource{ko})
+	if synced == nil || synced.Status != corev1.ConditionFalse {
+		t.Errorf("expected the endpoint not to be synced while IP addresses change, got %+v", synced)
+	}
+}
+
+func TestRecordFailedIPAddresses(t *testing.T) {
+	newLatest := func() *resource {
+		latest := newTestEndpoint(
+			newTestIPAddress("subnet-a", ""),
+			newTestIPAddress("subnet-b", ""),
+		)
+		latest.ko.Spec.AutoRemediation = lo.ToPtr(true)
+		latest.ko.Status.IPAddresses = []*svcapitypes.IPAddressResponse{
+			newTestAttachedIPAddress("rni-a", "subnet-a", "10.0.0.10", "ATTACHED"),
+			newTestAttachedIPAddress("rni-b", "subnet-b", "10.0.1.10", "FAILED_RESOURCE_GONE"),
+		}
+		return latest
+	}
+
+	latest := newLatest()
+	recordFailedIPAddresses(latest, latest.ko)
+	if step := lo.FromPtr(latest.ko.Status.IPAddressRemediations["rni-b"]); step != remediationReplacementNeeded {
+		t.Fatalf("expected the failed IP address to need a replacement, got %q", step)
+	}
+	delta := ackcompare.NewDelta()
+	customPreCompare(delta, newLatest(), latest)
+	if !delta.DifferentAt("Status.IPAddressRemediations") {
+		t.Errorf("expected the recorded remediation to be a difference")
+	}
+
+	readOnly := newLatest()
+	readOnly.ko.Annotations = map[string]string{ackv1alpha1.AnnotationReadOnly: "true"}
+	recordFailedIPAddresses(readOnly, readOnly.ko)
+	if len(readOnly.ko.Status.IPAddressRemediations) > 0 {
+		t.Errorf("expected no remediation on a read-only endpoint")
+	}
+}
+
+func TestRecordFailedIPAddresses_ActionNeeded(t *testing.T) {
+	latest := newTestEndpoint(
+		newTestIPAddress("subnet-a", ""),
+		newTestIPAddress("subnet-b", ""),
+	)
+	latest.ko.Spec.AutoRemediation = lo.ToPtr(true)
+	latest.ko.Status.Status = lo.ToPtr("ACTION_NEEDED")
+	latest.ko.Status.StatusMessage = lo.ToPtr("an elastic network interface was deleted")
+	// No IP address is flagged as failed, one is stuck detaching.
+	latest.ko.Status.IPAddresses = []*svcapitypes.IPAddressResponse{
+		newTestAttachedIPAddress("rni-a", "subnet-a", "10.0.0.10", "ATTACHED"),
+		newTestAttachedIPAddress("rni-b", "subnet-b", "10.0.1.10", "DETACHING"),
+	}
+
+	recordFailedIPAddresses(latest, latest.ko)
+	setIPAddressesCondition(latest.ko, latest.ko.Spec.IPAddresses)
+
+	if step := lo.FromPtr(latest.ko.Status.IPAddressRemediations["rni-b"]); step != remediationReplacementNeeded {
+		t.Fatalf("expected the detached IP address to need a replacement, got %q", step)
+	}
+	if _, found := latest.ko.Status.IPAddressRemediations["rni-a"]; found {
+		t.Errorf("expected the attached IP address to be kept")
+	}
+	healthy := findCondition(latest.ko, ConditionTypeIPAddressesHealthy)
+	if healthy == nil || healthy.Status != corev1.ConditionFalse ||
+		lo.FromPtr(healthy.Reason) != ipAddressesReasonActionNeeded {
+		t.Errorf("expected the IPAddressesHealthy condition to report the needed action, got %+v", healthy)
+	}
+	if hasPendingIPAddresses(latest.ko) {
+		t.Errorf("expected the IP address being replaced not to be pending")
+	}
+	delta := ackcompare.NewDelta()
+	customPreCompare(delta, latest, latest)
+	if !delta.DifferentAt("Status.IPAddressRemediations") {
+		t.Errorf("expected the recorded remediation to be a difference")
+	}
+}
+
+func TestFreeIPv6(t *testing.T) {
+	used := map[netip.Addr]bool{
+		netip.MustParseAddr("2001:db8:1:2::10a"): true,
+	}
+	ipv6, err := freeIPv6("2001:db8:1:2::/64", "10.0.1.10", used)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := lo.FromPtr(ipv6); got != "2001:db8:1:2::10b" {
+		t.Errorf("expected the next free address after the one in use, got %s", got)
+	}
+	if !used[netip.MustParseAddr("2001:db8:1:2::10b")] {
+		t.Errorf("expected the chosen address to be marked as used")
+	}
+
+	ipv6, err = freeIPv6("2001:db8:1:2::/64", "10.0.0.1", used)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := lo.FromPtr(ipv6); got != "2001:db8:1:2::4" {
+		t.Errorf("expected the first address after the reserved ones, got %s", got)
+	}
+}
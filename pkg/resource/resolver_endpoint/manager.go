@@ -50,7 +50,7 @@ var (
 // +kubebuilder:rbac:groups=route53resolver.services.k8s.aws,resources=resolverendpoints,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=route53resolver.services.k8s.aws,resources=resolverendpoints/status,verbs=get;update;patch
 
-var lateInitializeFieldNames = []string{}
+var lateInitializeFieldNames = []string{"Protocols"}
 
 // resourceManager is responsible for providing a consistent way to perform
 // CRUD operations in a backend AWS service API for Book custom resources.
@@ -251,6 +251,10 @@ func (rm *resourceManager) LateInitialize(
 func (rm *resourceManager) incompleteLateInitialization(
 	res acktypes.AWSResource,
 ) bool {
+	ko := rm.concreteResource(res).ko.DeepCopy()
+	if ko.Spec.Protocols == nil {
+		return true
+	}
 	return false
 }
 
@@ -260,7 +264,12 @@ func (rm *resourceManager) lateInitializeFromReadOneOutput(
 	observed acktypes.AWSResource,
 	latest acktypes.AWSResource,
 ) acktypes.AWSResource {
-	return latest
+	observedKo := rm.concreteResource(observed).ko.DeepCopy()
+	latestKo := rm.concreteResource(latest).ko.DeepCopy()
+	if observedKo.Spec.Protocols != nil && latestKo.Spec.Protocols == nil {
+		latestKo.Spec.Protocols = observedKo.Spec.Protocols
+	}
+	return &resource{latestKo}
 }
 
 // IsSynced returns true if the resource is synced.
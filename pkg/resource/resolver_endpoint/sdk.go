@@ -63,6 +63,16 @@ func (rm *resourceManager) sdkFind(
 	defer func() {
 		exit(err)
 	}()
+	if r.ko.Status.ID == nil && r.ko.Status.CreatorRequestID != nil {
+		if r, err = rm.findByCreatorRequestId(ctx, r); err != nil {
+			return nil, err
+		}
+	}
+	if r.ko.Spec.AdoptionFilters != nil {
+		if r, err = rm.findByAdoptionFilters(ctx, r); err != nil {
+			return nil, err
+		}
+	}
 	// If any required fields in the input shape are missing, AWS resource is
 	// not created yet. Return NotFound here to indicate to callers that the
 	// resource isn't yet created.
@@ -138,6 +148,27 @@ func (rm *resourceManager) sdkFind(
 	} else {
 		ko.Spec.Name = nil
 	}
+	if resp.ResolverEndpoint.OutpostArn != nil {
+		ko.Spec.OutpostARN = resp.ResolverEndpoint.OutpostArn
+	} else {
+		ko.Spec.OutpostARN = nil
+	}
+	if resp.ResolverEndpoint.PreferredInstanceType != nil {
+		ko.Spec.PreferredInstanceType = resp.ResolverEndpoint.PreferredInstanceType
+	} else {
+		ko.Spec.PreferredInstanceType = nil
+	}
+	if resp.ResolverEndpoint.Protocols != nil {
+		f11 := []*string{}
+		for _, f11iter := range resp.ResolverEndpoint.Protocols {
+			var f11elem *string
+			f11elem = aws.String(string(f11iter))
+			f11 = append(f11, f11elem)
+		}
+		ko.Spec.Protocols = f11
+	} else {
+		ko.Spec.Protocols = nil
+	}
 	if resp.ResolverEndpoint.ResolverEndpointType != "" {
 		ko.Spec.ResolverEndpointType = aws.String(string(resp.ResolverEndpoint.ResolverEndpointType))
 	} else {
@@ -160,7 +191,13 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
-	rm.ListAttachedIPAddresses(ctx, ko)
+	if err = rm.ListAttachedIPAddresses(ctx, ko); err != nil {
+		return nil, err
+	}
+	recordFailedIPAddresses(r, ko)
+	setIPAddressesCondition(ko, r.ko.Spec.IPAddresses)
+	setRemediationCondition(ko)
+	setDualStackMigrationCondition(ko, r.ko.Spec.ResolverEndpointType)
 
 	tags, err := rm.getTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
 	if err != nil {
@@ -207,16 +244,23 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err = validateProtocols(desired); err != nil {
+		return nil, err
+	}
+	if err = validateOutpost(desired); err != nil {
+		return nil, err
+	}
+	if err = ensureCreatorRequestId(desired); err != nil {
+		return desired, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
 	}
 	// A unique string that identifies the request and that allows failed requests to be
-	// retried without the risk of running the operation twice.
-	// CreatorRequestId can be any unique string, for example, a date/time stamp.
-	// TODO: Name is not sufficient, since a failed request cannot be retried.
-	// We might need to import the `time` package into `sdk.go`
-	input.CreatorRequestId = getCreatorRequestId(desired.ko)
+	// retried without the risk of running the operation twice. It was stored in
+	// status by the pre build hook before this call.
+	input.CreatorRequestId = desired.ko.Status.CreatorRequestID
 
 	var resp *svcsdk.CreateResolverEndpointOutput
 	_ = resp
@@ -277,6 +321,27 @@ func (rm *resourceManager) sdkCreate(
 	} else {
 		ko.Spec.Name = nil
 	}
+	if resp.ResolverEndpoint.OutpostArn != nil {
+		ko.Spec.OutpostARN = resp.ResolverEndpoint.OutpostArn
+	} else {
+		ko.Spec.OutpostARN = nil
+	}
+	if resp.ResolverEndpoint.PreferredInstanceType != nil {
+		ko.Spec.PreferredInstanceType = resp.ResolverEndpoint.PreferredInstanceType
+	} else {
+		ko.Spec.PreferredInstanceType = nil
+	}
+	if resp.ResolverEndpoint.Protocols != nil {
+		f11 := []*string{}
+		for _, f11iter := range resp.ResolverEndpoint.Protocols {
+			var f11elem *string
+			f11elem = aws.String(string(f11iter))
+			f11 = append(f11, f11elem)
+		}
+		ko.Spec.Protocols = f11
+	} else {
+		ko.Spec.Protocols = nil
+	}
 	if resp.ResolverEndpoint.ResolverEndpointType != "" {
 		ko.Spec.ResolverEndpointType = aws.String(string(resp.ResolverEndpoint.ResolverEndpointType))
 	} else {
@@ -299,7 +364,10 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
-	rm.ListAttachedIPAddresses(ctx, ko)
+	if err = rm.ListAttachedIPAddresses(ctx, ko); err != nil {
+		return &resource{ko}, err
+	}
+	setIPAddressesCondition(ko, desired.ko.Spec.IPAddresses)
 	return &resource{ko}, nil
 }
 
@@ -334,6 +402,21 @@ func (rm *resourceManager) newCreateRequestPayload(
 	if r.ko.Spec.Name != nil {
 		res.Name = r.ko.Spec.Name
 	}
+	if r.ko.Spec.OutpostARN != nil {
+		res.OutpostArn = r.ko.Spec.OutpostARN
+	}
+	if r.ko.Spec.PreferredInstanceType != nil {
+		res.PreferredInstanceType = r.ko.Spec.PreferredInstanceType
+	}
+	if r.ko.Spec.Protocols != nil {
+		f5 := []svcsdktypes.Protocol{}
+		for _, f5iter := range r.ko.Spec.Protocols {
+			var f5elem string
+			f5elem = string(*f5iter)
+			f5 = append(f5, svcsdktypes.Protocol(f5elem))
+		}
+		res.Protocols = f5
+	}
 	if r.ko.Spec.ResolverEndpointType != nil {
 		res.ResolverEndpointType = svcsdktypes.ResolverEndpointType(*r.ko.Spec.ResolverEndpointType)
 	}
@@ -341,18 +424,18 @@ func (rm *resourceManager) newCreateRequestPayload(
 		res.SecurityGroupIds = aws.ToStringSlice(r.ko.Spec.SecurityGroupIDs)
 	}
 	if r.ko.Spec.Tags != nil {
-		f5 := []svcsdktypes.Tag{}
-		for _, f5iter := range r.ko.Spec.Tags {
-			f5elem := &svcsdktypes.Tag{}
-			if f5iter.Key != nil {
-				f5elem.Key = f5iter.Key
+		f8 := []svcsdktypes.Tag{}
+		for _, f8iter := range r.ko.Spec.Tags {
+			f8elem := &svcsdktypes.Tag{}
+			if f8iter.Key != nil {
+				f8elem.Key = f8iter.Key
 			}
-			if f5iter.Value != nil {
-				f5elem.Value = f5iter.Value
+			if f8iter.Value != nil {
+				f8elem.Value = f8iter.Value
 			}
-			f5 = append(f5, *f5elem)
+			f8 = append(f8, *f8elem)
 		}
-		res.Tags = f5
+		res.Tags = f8
 	}
 
 	return res, nil
@@ -371,11 +454,32 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	// The IP address state and conditions are kept in status by sdkFind, so
+	// they have to survive the update, which starts from a copy of desired.
+	copyIPAddressStatus(desired.ko, latest.ko)
+	if delta.DifferentAt("Status.IPAddressRemediations") && canRemediate(desired) {
+		if err = rm.remediateIPAddresses(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		setRemediationCondition(desired.ko)
+	}
+	if err = validateProtocols(desired); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.ResolverEndpointType") {
+		if err = validateEndpointTypeChange(desired, latest); err != nil {
+			return nil, err
+		}
+		if isUpdating(latest) {
+			return nil, RequeueWhileUpdating
+		}
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
 		}
-	} else if !delta.DifferentExcept("Spec.Tags") {
+	}
+	if !delta.DifferentExcept("Spec.Tags", "Status.IPAddressRemediations") {
 		return desired, nil
 	}
 
@@ -383,6 +487,11 @@ func (rm *resourceManager) sdkUpdate(
 	if err != nil {
 		return nil, err
 	}
+	if isDualStackMigration(desired, latest) {
+		if input.UpdateIpAddresses, err = rm.newDualStackIPAddresses(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
 
 	var resp *svcsdk.UpdateResolverEndpointOutput
 	_ = resp
@@ -443,6 +552,27 @@ func (rm *resourceManager) sdkUpdate(
 	} else {
 		ko.Spec.Name = nil
 	}
+	if resp.ResolverEndpoint.OutpostArn != nil {
+		ko.Spec.OutpostARN = resp.ResolverEndpoint.OutpostArn
+	} else {
+		ko.Spec.OutpostARN = nil
+	}
+	if resp.ResolverEndpoint.PreferredInstanceType != nil {
+		ko.Spec.PreferredInstanceType = resp.ResolverEndpoint.PreferredInstanceType
+	} else {
+		ko.Spec.PreferredInstanceType = nil
+	}
+	if resp.ResolverEndpoint.Protocols != nil {
+		f11 := []*string{}
+		for _, f11iter := range resp.ResolverEndpoint.Protocols {
+			var f11elem *string
+			f11elem = aws.String(string(f11iter))
+			f11 = append(f11, f11elem)
+		}
+		ko.Spec.Protocols = f11
+	} else {
+		ko.Spec.Protocols = nil
+	}
 	if resp.ResolverEndpoint.ResolverEndpointType != "" {
 		ko.Spec.ResolverEndpointType = aws.String(string(resp.ResolverEndpoint.ResolverEndpointType))
 	} else {
@@ -465,10 +595,14 @@ func (rm *resourceManager) sdkUpdate(
 	}
 
 	rm.setStatusDefaults(ko)
-	if delta.DifferentAt("Spec.IPAddresses") {
-		rm.SyncIPAddresses(ctx, desired, latest)
+	if delta.DifferentAt("Spec.IPAddresses") && !isRemediating(latest.ko) && !isDualStackMigration(desired, latest) {
+		if err = rm.SyncIPAddresses(ctx, desired, latest); err != nil {
+			return nil, err
+		}
 		ko.Status.IPAddressCount = latest.ko.Status.IPAddressCount
+		setIPAddressesPending(ko)
 	}
+	setDualStackMigrationCondition(ko, desired.ko.Spec.ResolverEndpointType)
 	return &resource{ko}, nil
 }
 
@@ -484,6 +618,15 @@ func (rm *resourceManager) newUpdateRequestPayload(
 	if r.ko.Spec.Name != nil {
 		res.Name = r.ko.Spec.Name
 	}
+	if r.ko.Spec.Protocols != nil {
+		f1 := []svcsdktypes.Protocol{}
+		for _, f1iter := range r.ko.Spec.Protocols {
+			var f1elem string
+			f1elem = string(*f1iter)
+			f1 = append(f1, svcsdktypes.Protocol(f1elem))
+		}
+		res.Protocols = f1
+	}
 	if r.ko.Status.ID != nil {
 		res.ResolverEndpointId = r.ko.Status.ID
 	}
@@ -631,6 +774,18 @@ func (rm *resourceManager) updateConditions(
 // and if the exception indicates that it is a Terminal exception
 // 'Terminal' exception are specified in generator configuration
 func (rm *resourceManager) terminalAWSError(err error) bool {
-	// No terminal_errors specified for this resource in generator config
-	return false
+	if err == nil {
+		return false
+	}
+
+	var terminalErr smithy.APIError
+	if !errors.As(err, &terminalErr) {
+		return false
+	}
+	switch terminalErr.ErrorCode() {
+	case "InvalidParameterException":
+		return true
+	default:
+		return false
+	}
 }
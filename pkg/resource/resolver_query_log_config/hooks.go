@@ -2,12 +2,50 @@ package resolver_query_log_config
 
 import (
 	"context"
+	"fmt"
 
 	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/adoption"
 	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/tags"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// +kubebuilder:rbac:groups=s3.services.k8s.aws,resources=buckets,verbs=get;list
+// +kubebuilder:rbac:groups=s3.services.k8s.aws,resources=buckets/status,verbs=get;list
+
+// +kubebuilder:rbac:groups=cloudwatchlogs.services.k8s.aws,resources=loggroups,verbs=get;list
+// +kubebuilder:rbac:groups=cloudwatchlogs.services.k8s.aws,resources=loggroups/status,verbs=get;list
+
+// +kubebuilder:rbac:groups=firehose.services.k8s.aws,resources=deliverystreams,verbs=get;list
+// +kubebuilder:rbac:groups=firehose.services.k8s.aws,resources=deliverystreams/status,verbs=get;list
+
+// destinationKinds maps the kinds spec.destinationRef can refer to to the
+// group, version and kind of the ACK resource. The resources are read as
+// unstructured objects, so that the controllers managing them are optional.
+var destinationKinds = map[string]schema.GroupVersionKind{
+	"Bucket": {
+		Group: "s3.services.k8s.aws", Version: "v1alpha1", Kind: "Bucket",
+	},
+	"LogGroup": {
+		Group: "cloudwatchlogs.services.k8s.aws", Version: "v1alpha1", Kind: "LogGroup",
+	},
+	"DeliveryStream": {
+		Group: "firehose.services.k8s.aws", Version: "v1alpha1", Kind: "DeliveryStream",
+	},
+}
+
 func (rm *resourceManager) customUpdateResolverQueryLogConfig(
 	ctx context.Context,
 	desired *resource,
@@ -22,6 +60,57 @@ func (rm *resourceManager) customUpdateResolverQueryLogConfig(
 	return desired, nil
 }
 
+// findByAdoptionFilters looks up the query logging configuration to adopt with spec.adoptionFilters
+// when the resource is being adopted and has no ID yet. It returns a copy of
+// the resource with the ID of the only matching query logging configuration, and an error
+// when none or several match.
+func (rm *resourceManager) findByAdoptionFilters(
+	ctx context.Context,
+	r *resource,
+) (found *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.findByAdoptionFilters")
+	defer exit(err)
+
+	if !adoption.NeedsLookup(r, r.ko.Status.ID, r.ko.Spec.AdoptionFilters) {
+		return r, nil
+	}
+	apiFilters, tagFilters := adoption.SplitFilters(r.ko.Spec.AdoptionFilters)
+	candidates := []adoption.Candidate{}
+	var nextToken *string
+	for {
+		resp, err := rm.sdkapi.ListResolverQueryLogConfigs(
+			ctx,
+			&svcsdk.ListResolverQueryLogConfigsInput{
+				Filters:   apiFilters,
+				NextToken: nextToken,
+			},
+		)
+		rm.metrics.RecordAPICall("READ_MANY", "ListResolverQueryLogConfigs", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, elem := range resp.ResolverQueryLogConfigs {
+			candidates = append(candidates, adoption.Candidate{
+				ID:  elem.Id,
+				ARN: elem.Arn,
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+
+	id, err := adoption.FindOne(ctx, rm.sdkapi, rm.metrics, candidates, tagFilters)
+	if err != nil {
+		return nil, err
+	}
+	found = &resource{r.ko.DeepCopy()}
+	found.ko.Status.ID = id
+	return found, nil
+}
+
 func (rm *resourceManager) getTags(
 	ctx context.Context,
 	resourceARN string,
@@ -36,3 +125,126 @@ func (rm *resourceManager) syncTags(
 ) error {
 	return tags.SyncTags(ctx, desired.ko.Spec.Tags, latest.ko.Spec.Tags, latest.ko.Status.ACKResourceMetadata, convertToOrderedACKTags, rm.sdkapi, rm.metrics)
 }
+
+// getReferencedDestinationARN returns the ARN of the S3 Bucket, CloudWatch
+// Logs LogGroup or Kinesis Data Firehose DeliveryStream that
+// spec.destinationRef refers to. Like any other reference, it returns
+// `ackerr.ResourceReferenceTerminalFor` or `ResourceReferenceNotSyncedFor`
+// until the referenced resource is in a ACK.ResourceSynced=True state, so the
+// query logging configuration is only created once its destination exists.
+func getReferencedDestinationARN(
+	ctx context.Context,
+	apiReader client.Reader,
+	kind *string,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) (string, error) {
+	gvk, ok := destinationKinds[lo.FromPtr(kind)]
+	if !ok {
+		return "", ackerr.NewTerminalError(fmt.Errorf(
+			"destinationRef kind %q is not supported, use Bucket, LogGroup or DeliveryStream",
+			lo.FromPtr(kind),
+		))
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	if err := apiReader.Get(ctx, namespacedName, obj); err != nil {
+		return "", err
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	var refResourceSynced bool
+	for _, elem := range conditions {
+		cond, ok := elem.(map[string]interface{})
+		if !ok || cond["status"] != string(corev1.ConditionTrue) {
+			continue
+		}
+		switch cond["type"] {
+		case string(ackv1alpha1.ConditionTypeTerminal):
+			return "", ackerr.ResourceReferenceTerminalFor(gvk.Kind, namespace, name)
+		case string(ackv1alpha1.ConditionTypeResourceSynced):
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return "", ackerr.ResourceReferenceNotSyncedFor(gvk.Kind, namespace, name)
+	}
+	arn, _, _ := unstructured.NestedString(obj.Object, "status", "ackResourceMetadata", "arn")
+	if arn == "" {
+		return "", ackerr.ResourceReferenceMissingTargetFieldFor(
+			gvk.Kind, namespace, name, "Status.ACKResourceMetadata.ARN",
+		)
+	}
+	return arn, nil
+}
+
+// validateDestinationFields returns an error unless exactly one of
+// spec.destinationARN and spec.destinationRef is set.
+func validateDestinationFields(ko *svcapitypes.ResolverQueryLogConfig) error {
+	if ko.Spec.DestinationRef != nil && ko.Spec.DestinationARN != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("DestinationARN", "DestinationRef")
+	}
+	if ko.Spec.DestinationRef == nil && ko.Spec.DestinationARN == nil {
+		return ackerr.ResourceReferenceOrIDRequiredFor("DestinationARN", "DestinationRef")
+	}
+	return nil
+}
+
+// resolveDestinationReference is called by ResolveReferences. The generated
+// reference resolution only handles references to a single kind, so
+// spec.destinationRef, which can refer to a Bucket, a LogGroup or a
+// DeliveryStream, is resolved here instead, with the same reader and the
+// same errors as the generated references.
+func (rm *resourceManager) resolveDestinationReference(
+	ctx context.Context,
+	apiReader client.Reader,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, bool, error) {
+	ko := rm.concreteResource(res).ko
+
+	hasReferences := ko.Spec.DestinationRef != nil
+	if err := validateDestinationFields(ko); err != nil {
+		return &resource{ko}, hasReferences, err
+	}
+	hasReferences, err := rm.resolveReferenceForDestinationARN(ctx, apiReader, ko)
+	return &resource{ko}, hasReferences, err
+}
+
+// resolveReferenceForDestinationARN reads the resource referenced from
+// DestinationRef and sets DestinationARN from its ARN. Returns a boolean
+// indicating whether the resource contains references, or an error.
+func (rm *resourceManager) resolveReferenceForDestinationARN(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.ResolverQueryLogConfig,
+) (hasReferences bool, err error) {
+	if ko.Spec.DestinationRef != nil && ko.Spec.DestinationRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.DestinationRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: DestinationRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		arn, err := getReferencedDestinationARN(ctx, apiReader, ko.Spec.DestinationRef.Kind, *arr.Name, namespace)
+		if err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.DestinationARN = &arn
+	}
+
+	return hasReferences, nil
+}
@@ -0,0 +1,159 @@
+package resolver_query_log_config
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/samber/lo"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+)
+
+const testBucketARN = "arn:aws:s3:::query-logs"
+
+func newTestBucket(name string, synced bool, arn string) client.Object {
+	bucket := &unstructured.Unstructured{}
+	bucket.SetGroupVersionKind(destinationKinds["Bucket"])
+	bucket.SetNamespace("default")
+	bucket.SetName(name)
+	status := "False"
+	if synced {
+		status = "True"
+	}
+	bucket.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{
+				"type":   string(ackv1alpha1.ConditionTypeResourceSynced),
+				"status": status,
+			},
+		},
+		"ackResourceMetadata": map[string]interface{}{
+			"arn": arn,
+		},
+	}
+	return bucket
+}
+
+func newTestConfig(destinationARN *string, bucketName string) *resource {
+	ko := &svcapitypes.ResolverQueryLogConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "query-log-config",
+		},
+	}
+	ko.Spec.DestinationARN = destinationARN
+	if bucketName != "" {
+		ko.Spec.DestinationRef = &svcapitypes.DestinationReference{
+			Kind: lo.ToPtr("Bucket"),
+			From: &ackv1alpha1.AWSResourceReference{Name: lo.ToPtr(bucketName)},
+		}
+	}
+	return &resource{ko}
+}
+
+func TestResolveReferences_DestinationRef(t *testing.T) {
+	apiReader := fake.NewClientBuilder().WithObjects(
+		newTestBucket("synced", true, testBucketARN),
+		newTestBucket("creating", false, ""),
+		newTestBucket("no-arn", true, ""),
+	).Build()
+	rm := &resourceManager{}
+
+	tests := []struct {
+		name           string
+		desired        *resource
+		wantARN        string
+		wantReferences bool
+		wantErr        func(error) bool
+	}{
+		{
+			name:           "synced bucket",
+			desired:        newTestConfig(nil, "synced"),
+			wantARN:        testBucketARN,
+			wantReferences: true,
+		},
+		{
+			name:           "bucket not synced yet",
+			desired:        newTestConfig(nil, "creating"),
+			wantReferences: true,
+			wantErr: func(err error) bool {
+				return errors.Is(err, ackerr.ResourceReferenceNotSynced)
+			},
+		},
+		{
+			name:           "bucket without an ARN",
+			desired:        newTestConfig(nil, "no-arn"),
+			wantReferences: true,
+			wantErr: func(err error) bool {
+				return errors.Is(err, ackerr.ResourceReferenceMissingTargetField)
+			},
+		},
+		{
+			name:           "missing bucket",
+			desired:        newTestConfig(nil, "missing"),
+			wantReferences: true,
+			wantErr:        apierrors.IsNotFound,
+		},
+		{
+			name:           "both destinationARN and destinationRef",
+			desired:        newTestConfig(lo.ToPtr(testBucketARN), "synced"),
+			wantReferences: true,
+			wantErr: func(err error) bool {
+				return errors.Is(err, ackerr.ResourceReferenceAndIDNotSupported)
+			},
+		},
+		{
+			name:    "neither destinationARN nor destinationRef",
+			desired: newTestConfig(nil, ""),
+			wantErr: func(err error) bool {
+				return errors.Is(err, ackerr.ResourceReferenceOrIDRequired)
+			},
+		},
+		{
+			name:    "destinationARN",
+			desired: newTestConfig(lo.ToPtr(testBucketARN), ""),
+			wantARN: testBucketARN,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, hasReferences, err := rm.ResolveReferences(context.Background(), apiReader, tt.desired)
+			if hasReferences != tt.wantReferences {
+				t.Errorf("ResolveReferences() hasReferences = %v, want %v", hasReferences, tt.wantReferences)
+			}
+			if tt.wantErr != nil {
+				if err == nil || !tt.wantErr(err) {
+					t.Errorf("ResolveReferences() error = %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveReferences() error = %v", err)
+			}
+			if got := lo.FromPtr(rm.concreteResource(resolved).ko.Spec.DestinationARN); got != tt.wantARN {
+				t.Errorf("ResolveReferences() destinationARN = %q, want %q", got, tt.wantARN)
+			}
+		})
+	}
+}
+
+func TestClearResolvedReferences_DestinationRef(t *testing.T) {
+	rm := &resourceManager{}
+	resolved := newTestConfig(lo.ToPtr(testBucketARN), "synced")
+
+	cleared := rm.concreteResource(rm.ClearResolvedReferences(resolved)).ko
+	if cleared.Spec.DestinationARN != nil {
+		t.Errorf("ClearResolvedReferences() destinationARN = %q, want nil", *cleared.Spec.DestinationARN)
+	}
+	if resolved.ko.Spec.DestinationARN == nil {
+		t.Error("ClearResolvedReferences() modified its input")
+	}
+}
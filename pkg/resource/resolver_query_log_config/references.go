@@ -32,6 +32,10 @@ import (
 func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
 	ko := rm.concreteResource(res).ko.DeepCopy()
 
+	if ko.Spec.DestinationRef != nil {
+		ko.Spec.DestinationARN = nil
+	}
+
 	return &resource{ko}
 }
 
@@ -47,6 +51,9 @@ func (rm *resourceManager) ResolveReferences(
 	apiReader client.Reader,
 	res acktypes.AWSResource,
 ) (acktypes.AWSResource, bool, error) {
+	if resolved, hasReferences, err := rm.resolveDestinationReference(ctx, apiReader, res); hasReferences || err != nil {
+		return resolved, hasReferences, err
+	}
 	return res, false, nil
 }
 
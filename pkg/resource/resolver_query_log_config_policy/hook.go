@@ -0,0 +1,95 @@
+package resolver_query_log_config_policy
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/policy"
+)
+
+// sharedActions are the actions a query logging configuration policy grants
+// to every principal.
+var sharedActions = []string{
+	"AssociateResolverQueryLogConfig",
+	"DisassociateResolverQueryLogConfig",
+	"ListResolverQueryLogConfigs",
+}
+
+// newPolicyDocument builds the query logging configuration policy that
+// grants the accounts in spec.principals the shared actions on the
+// configuration.
+func newPolicyDocument(ko *svcapitypes.ResolverQueryLogConfigPolicy) (*string, error) {
+	return policy.New(
+		aws.ToString(ko.Spec.ResolverQueryLogConfigARN),
+		aws.ToStringSlice(ko.Spec.Principals),
+		sharedActions,
+	)
+}
+
+// customPreCompare compares the policy built from the desired spec with the
+// policy read from AWS, so that accounts or actions changed outside the
+// controller are differences while formatting and ordering are not.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	policy.Compare(
+		delta,
+		policy.Fields{
+			Principals: "Spec.Principals",
+			Document:   "Status.ResolverQueryLogConfigPolicy",
+		},
+		aws.ToString(a.ko.Spec.ResolverQueryLogConfigARN),
+		aws.ToStringSlice(a.ko.Spec.Principals),
+		sharedActions,
+		b.ko.Status.ResolverQueryLogConfigPolicy,
+	)
+}
+
+// setPrincipals sets spec.principals from the policy read from AWS.
+func setPrincipals(
+	desired *svcapitypes.ResolverQueryLogConfigPolicy,
+	ko *svcapitypes.ResolverQueryLogConfigPolicy,
+) error {
+	principals, err := policy.Principals(desired.Spec.Principals, ko.Status.ResolverQueryLogConfigPolicy)
+	if err != nil {
+		return err
+	}
+	ko.Spec.Principals = principals
+	return nil
+}
+
+// customDeleteResolverQueryLogConfigPolicy stops sharing the query logging
+// configuration by replacing its policy with one that only grants the account
+// owning it.
+func (rm *resourceManager) customDeleteResolverQueryLogConfigPolicy(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customDeleteResolverQueryLogConfigPolicy")
+	defer func() {
+		exit(err)
+	}()
+
+	if r.ko.Spec.ResolverQueryLogConfigARN == nil {
+		return nil, nil
+	}
+	document, err := policy.OwnerOnly(*r.ko.Spec.ResolverQueryLogConfigARN, sharedActions)
+	if err != nil {
+		return nil, err
+	}
+	input := &svcsdk.PutResolverQueryLogConfigPolicyInput{
+		Arn:                          r.ko.Spec.ResolverQueryLogConfigARN,
+		ResolverQueryLogConfigPolicy: document,
+	}
+	_, err = rm.sdkapi.PutResolverQueryLogConfigPolicy(ctx, input)
+	rm.metrics.RecordAPICall("DELETE", "PutResolverQueryLogConfigPolicy", err)
+	return nil, policy.IgnoreNotFound(err)
+}
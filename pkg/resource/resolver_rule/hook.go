@@ -2,46 +2,181 @@ package resolver_rule
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
-	"time"
+	"sort"
+	"strings"
 
 	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/adoption"
 	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/tags"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionTypeAssociationConflict is the type of the condition that reports
+// VPCs in spec.associations whose association is owned by something other
+// than the rule.
+const ConditionTypeAssociationConflict ackv1alpha1.ConditionType = "AssociationConflict"
+
+// ConditionTypeDeletionProgressing is the type of the condition that reports
+// the stage a ResolverRule being deleted is in.
+const ConditionTypeDeletionProgressing ackv1alpha1.ConditionType = "DeletionProgressing"
+
+const (
+	// InlineAssociationName is the name given to the associations created
+	// for spec.associations. Associations with any other name were created
+	// by a ResolverRuleAssociation or another owner and are left alone.
+	InlineAssociationName = "ack-resolver-rule-inline"
+	// AnnotationExternalAssociationVPCs lists, comma separated, the VPCs whose
+	// association is owned outside of spec.associations.
+	AnnotationExternalAssociationVPCs = "route53resolver.services.k8s.aws/external-association-vpcs"
+
+	associationReasonConflict   = "Conflict"
+	associationReasonNoConflict = "NoConflict"
+
+	deletionReasonDisassociating           = "Disassociating"
+	deletionReasonWaitingForDisassociation = "WaitingForDisassociation"
+	deletionReasonBlocked                  = "BlockedByForeignAssociations"
+	deletionReasonDeleting                 = "Deleting"
 )
 
 var (
 	TypeVPCId       = "VPCID"
 	RequeueOnUpdate = requeue.Needed(fmt.Errorf("requeing to sync resource status"))
+
+	RequeueToPersistCreatorRequestId = requeue.Needed(
+		fmt.Errorf("requeuing to persist the creator request ID before creating the resolver rule"),
+	)
 )
 
-// getCreatorRequestId will generate a CreatorRequestId for a given resolver endpoint
-// using the name of the endpoint and the current timestamp, so that it produces a
-// unique value
+// getCreatorRequestId will generate a CreatorRequestId for a given resolver rule
+// from the UID and generation of the object, so that every create attempt for
+// the same generation uses the same value
 func getCreatorRequestId(rule *svcapitypes.ResolverRule) *string {
-	requestId := fmt.Sprintf("%s-%d", *rule.Spec.Name, time.Now().UnixMilli())
+	requestId := fmt.Sprintf("%s-%d", rule.UID, rule.Generation)
 	return &requestId
 }
 
-// addRulesToSpec updates a resource's Spec EgressRules and IngressRules
-// using data from a DescribeSecurityGroups response
+// ensureCreatorRequestId stores the CreatorRequestId of the next create call
+// in status. When the stored value changes it returns
+// RequeueToPersistCreatorRequestId, so that the value is saved to the cluster
+// before the rule is created and a retried create can find it.
+func ensureCreatorRequestId(r *resource) error {
+	requestId := getCreatorRequestId(r.ko)
+	if lo.FromPtr(r.ko.Status.CreatorRequestID) == *requestId {
+		return nil
+	}
+	r.ko.Status.CreatorRequestID = requestId
+	return RequeueToPersistCreatorRequestId
+}
+
+// findByCreatorRequestId looks up the rule created with the CreatorRequestId
+// stored in status. It returns a copy of the resource with the ID of that
+// rule, so that a rule created by an earlier attempt is adopted instead of
+// created again, or the resource itself if there is none.
+func (rm *resourceManager) findByCreatorRequestId(
+	ctx context.Context,
+	r *resource,
+) (found *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.findByCreatorRequestId")
+	defer exit(err)
+
+	resp, err := rm.sdkapi.ListResolverRules(
+		ctx,
+		&svcsdk.ListResolverRulesInput{
+			Filters: []svcsdktypes.Filter{
+				{
+					Name:   lo.ToPtr("CreatorRequestId"),
+					Values: []string{*r.ko.Status.CreatorRequestID},
+				},
+			},
+		},
+	)
+	rm.metrics.RecordAPICall("READ_MANY", "ListResolverRules", err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.ResolverRules) == 0 {
+		return r, nil
+	}
+	found = &resource{r.ko.DeepCopy()}
+	found.ko.Status.ID = resp.ResolverRules[0].Id
+	return found, nil
+}
+
+// findByAdoptionFilters looks up the rule to adopt with spec.adoptionFilters
+// when the resource is being adopted and has no ID yet. It returns a copy of
+// the resource with the ID of the only matching rule, and an error
+// when none or several match.
+func (rm *resourceManager) findByAdoptionFilters(
+	ctx context.Context,
+	r *resource,
+) (found *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.findByAdoptionFilters")
+	defer exit(err)
+
+	if !adoption.NeedsLookup(r, r.ko.Status.ID, r.ko.Spec.AdoptionFilters) {
+		return r, nil
+	}
+	apiFilters, tagFilters := adoption.SplitFilters(r.ko.Spec.AdoptionFilters)
+	candidates := []adoption.Candidate{}
+	var nextToken *string
+	for {
+		resp, err := rm.sdkapi.ListResolverRules(
+			ctx,
+			&svcsdk.ListResolverRulesInput{
+				Filters:   apiFilters,
+				NextToken: nextToken,
+			},
+		)
+		rm.metrics.RecordAPICall("READ_MANY", "ListResolverRules", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, elem := range resp.ResolverRules {
+			candidates = append(candidates, adoption.Candidate{
+				ID:  elem.Id,
+				ARN: elem.Arn,
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+
+	id, err := adoption.FindOne(ctx, rm.sdkapi, rm.metrics, candidates, tagFilters)
+	if err != nil {
+		return nil, err
+	}
+	found = &resource{r.ko.DeepCopy()}
+	found.ko.Status.ID = id
+	return found, nil
+}
+
+// getAttachedVPC lists every association of the rule, following NextToken,
+// and returns each of them with its ID, name, status and status message.
 func (rm *resourceManager) getAttachedVPC(
 	ctx context.Context,
 	latest *resource,
-) (associationList []*svcapitypes.ResolverRuleAssociation_SDK, err error) {
+) (observed []*svcapitypes.ResolverRuleAssociation_SDK, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.getAttachedVPC")
-	defer func(err error) {
-		exit(err)
-	}(err)
+	defer exit(err)
 
-	// ko.Spec.Associations = nil
 	input := &svcsdk.ListResolverRuleAssociationsInput{
 		Filters: []svcsdktypes.Filter{
 			{
@@ -50,16 +185,288 @@ func (rm *resourceManager) getAttachedVPC(
 			},
 		},
 	}
-	resolverRuleList, err := rm.sdkapi.ListResolverRuleAssociations(ctx, input)
-	rm.metrics.RecordAPICall("READ_ONE", "ListResolverRuleAssociations", err)
-	for _, association := range resolverRuleList.ResolverRuleAssociations {
-		if association.Status != svcsdktypes.ResolverRuleAssociationStatusDeleting {
-			var svcassociation svcapitypes.ResolverRuleAssociation_SDK
-			svcassociation.VPCID = association.VPCId
-			associationList = append(associationList, &svcassociation)
+	for {
+		resp, err := rm.sdkapi.ListResolverRuleAssociations(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "ListResolverRuleAssociations", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, association := range resp.ResolverRuleAssociations {
+			observed = append(observed, &svcapitypes.ResolverRuleAssociation_SDK{
+				ID:             association.Id,
+				Name:           association.Name,
+				ResolverRuleID: association.ResolverRuleId,
+				Status:         lo.ToPtr(string(association.Status)),
+				StatusMessage:  association.StatusMessage,
+				VPCID:          association.VPCId,
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	return observed, nil
+}
+
+// externalAssociationVPCs returns the VPCs listed in the
+// AnnotationExternalAssociationVPCs annotation of the rule.
+func externalAssociationVPCs(ko *svcapitypes.ResolverRule) map[string]bool {
+	vpcs := map[string]bool{}
+	for _, vpc := range strings.Split(ko.GetAnnotations()[AnnotationExternalAssociationVPCs], ",") {
+		if vpc = strings.TrimSpace(vpc); vpc != "" {
+			vpcs[vpc] = true
+		}
+	}
+	return vpcs
+}
+
+// legacyAssociationVPCs returns the VPCs listed in
+// status.legacyAssociationVPCs.
+func legacyAssociationVPCs(ko *svcapitypes.ResolverRule) map[string]bool {
+	return lo.SliceToMap(aws.ToStringSlice(ko.Status.LegacyAssociationVPCs), func(vpc string) (string, bool) {
+		return vpc, true
+	})
+}
+
+// isInlineAssociation returns true if the association belongs to
+// spec.associations and its VPC is not listed as external: it is named with
+// InlineAssociationName, or it is an unnamed association created before
+// associations were named and claimed in legacy. Any other unnamed
+// association may have been created by another owner and is left alone.
+func isInlineAssociation(
+	association *svcapitypes.ResolverRuleAssociation_SDK,
+	external map[string]bool,
+	legacy map[string]bool,
+) bool {
+	vpc := lo.FromPtr(association.VPCID)
+	if external[vpc] {
+		return false
+	}
+	switch lo.FromPtr(association.Name) {
+	case InlineAssociationName:
+		return true
+	case "":
+		return legacy[vpc]
+	}
+	return false
+}
+
+// recordLegacyAssociations sets status.legacyAssociationVPCs to the VPCs
+// whose unnamed association belongs to spec.associations. Associations
+// created before they were named with InlineAssociationName have no name. One
+// is claimed when its VPC is in spec.associations, and stays claimed for as
+// long as it exists, so that removing the VPC from spec.associations still
+// disassociates it.
+func recordLegacyAssociations(desired, ko *svcapitypes.ResolverRule) {
+	external := externalAssociationVPCs(desired)
+	claimed := legacyAssociationVPCs(desired)
+	for _, association := range desired.Spec.Associations {
+		claimed[lo.FromPtr(association.VPCID)] = true
+	}
+	vpcs := []string{}
+	for _, association := range ko.Status.VPCAssociations {
+		vpc := lo.FromPtr(association.VPCID)
+		if lo.FromPtr(association.Name) == "" && claimed[vpc] && !external[vpc] && !lo.Contains(vpcs, vpc) {
+			vpcs = append(vpcs, vpc)
+		}
+	}
+	ko.Status.LegacyAssociationVPCs = nil
+	if len(vpcs) > 0 {
+		sort.Strings(vpcs)
+		ko.Status.LegacyAssociationVPCs = aws.StringSlice(vpcs)
+	}
+}
+
+// isActiveAssociation returns true if the association is not being deleted.
+func isActiveAssociation(association *svcapitypes.ResolverRuleAssociation_SDK) bool {
+	return lo.FromPtr(association.Status) != string(svcsdktypes.ResolverRuleAssociationStatusDeleting)
+}
+
+// foreignAssociationVPCs returns the VPCs that inline sync must neither
+// associate nor disassociate: the VPCs listed as external and the VPCs with
+// an association that does not belong to spec.associations.
+func foreignAssociationVPCs(ko *svcapitypes.ResolverRule) map[string]bool {
+	external := externalAssociationVPCs(ko)
+	legacy := legacyAssociationVPCs(ko)
+	foreign := lo.Assign(external)
+	for _, association := range ko.Status.VPCAssociations {
+		if isActiveAssociation(association) && !isInlineAssociation(association, external, legacy) {
+			foreign[lo.FromPtr(association.VPCID)] = true
+		}
+	}
+	return foreign
+}
+
+// inlineAssociations returns the associations of ko reported in
+// spec.associations. Associations owned elsewhere are only reported when
+// their VPC is desired, so that they neither show up as a difference nor get
+// disassociated by the rule.
+func inlineAssociations(
+	desired *svcapitypes.ResolverRule,
+	ko *svcapitypes.ResolverRule,
+) (associationList []*svcapitypes.ResolverRuleAssociation_SDK) {
+	external := externalAssociationVPCs(desired)
+	legacy := legacyAssociationVPCs(ko)
+	desiredVPCs := map[string]bool{}
+	for _, association := range desired.Spec.Associations {
+		desiredVPCs[lo.FromPtr(association.VPCID)] = true
+	}
+	for _, association := range ko.Status.VPCAssociations {
+		if !isActiveAssociation(association) {
+			continue
+		}
+		if isInlineAssociation(association, external, legacy) || desiredVPCs[lo.FromPtr(association.VPCID)] {
+			associationList = append(associationList, &svcapitypes.ResolverRuleAssociation_SDK{
+				VPCID: association.VPCID,
+			})
 		}
 	}
-	return associationList, nil
+	return associationList
+}
+
+// setAssociationConflictCondition reports the desired VPCs whose association
+// is owned elsewhere, either because the VPC is listed as external or because
+// it is associated under another name, in the AssociationConflict condition.
+func setAssociationConflictCondition(
+	ko *svcapitypes.ResolverRule,
+	desired []*svcapitypes.ResolverRuleAssociation_SDK,
+) {
+	external := externalAssociationVPCs(ko)
+	legacy := legacyAssociationVPCs(ko)
+	owners := map[string]string{}
+	for _, association := range ko.Status.VPCAssociations {
+		if isActiveAssociation(association) && !isInlineAssociation(association, nil, legacy) {
+			owners[lo.FromPtr(association.VPCID)] = lo.FromPtr(association.Name)
+		}
+	}
+	conflicts := []string{}
+	for _, association := range desired {
+		vpc := lo.FromPtr(association.VPCID)
+		if external[vpc] {
+			conflicts = append(conflicts, fmt.Sprintf("%s is listed in %s", vpc, AnnotationExternalAssociationVPCs))
+		} else if owner, ok := owners[vpc]; ok {
+			conflicts = append(conflicts, fmt.Sprintf("%s is associated by %q", vpc, owner))
+		}
+	}
+	if len(conflicts) == 0 {
+		setAssociationConflict(
+			ko, corev1.ConditionFalse, associationReasonNoConflict,
+			"no association in spec.associations is owned elsewhere",
+		)
+		return
+	}
+	sort.Strings(conflicts)
+	setAssociationConflict(
+		ko, corev1.ConditionTrue, associationReasonConflict,
+		fmt.Sprintf("associations owned elsewhere are left alone: %s", strings.Join(conflicts, "; ")),
+	)
+}
+
+func setAssociationConflict(
+	ko *svcapitypes.ResolverRule,
+	status corev1.ConditionStatus,
+	reason string,
+	message string,
+) {
+	setCondition(ko, ConditionTypeAssociationConflict, status, reason, message)
+}
+
+// prepareDelete disassociates the rule from its VPCs before it is deleted,
+// one stage per reconcile: the associations of spec.associations are
+// disassociated first, then the rule waits until no association is left.
+// Associations owned elsewhere are never disassociated and block the
+// deletion until their owner removes them. Each stage is reported in the
+// DeletionProgressing condition of the returned copy of the resource, with a
+// requeue error until the rule can be deleted.
+func (rm *resourceManager) prepareDelete(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.prepareDelete")
+	defer exit(err)
+
+	latest = rm.concreteResource(r.DeepCopy())
+	ko := latest.ko
+	if ko.Status.ID == nil {
+		return latest, nil
+	}
+
+	external := externalAssociationVPCs(ko)
+	legacy := legacyAssociationVPCs(ko)
+	toDelete := map[string]string{}
+	foreign := []string{}
+	remaining := 0
+	for _, association := range ko.Status.VPCAssociations {
+		remaining++
+		if !isActiveAssociation(association) {
+			continue
+		}
+		vpc := lo.FromPtr(association.VPCID)
+		if isInlineAssociation(association, external, legacy) {
+			toDelete[vpc] = TypeVPCId
+		} else {
+			foreign = append(foreign, fmt.Sprintf("%s (%q)", vpc, lo.FromPtr(association.Name)))
+		}
+	}
+
+	if len(toDelete) > 0 {
+		if err = rm.deleteOldAssociations(ctx, latest, latest, toDelete); err != nil {
+			return latest, err
+		}
+		msg := fmt.Sprintf("disassociating the rule from %d VPCs", len(toDelete))
+		setCondition(ko, ConditionTypeDeletionProgressing, corev1.ConditionTrue, deletionReasonDisassociating, msg)
+		return latest, requeue.NeededAfter(errors.New(msg), requeue.DefaultRequeueAfterDuration)
+	}
+	if len(foreign) > 0 {
+		sort.Strings(foreign)
+		msg := fmt.Sprintf(
+			"cannot delete the rule while it is associated with VPCs owned elsewhere: %s",
+			strings.Join(foreign, ", "),
+		)
+		setCondition(ko, ConditionTypeDeletionProgressing, corev1.ConditionFalse, deletionReasonBlocked, msg)
+		return latest, requeue.NeededAfter(errors.New(msg), requeue.DefaultRequeueAfterDuration)
+	}
+	if remaining > 0 {
+		msg := fmt.Sprintf("waiting for %d associations to be deleted", remaining)
+		setCondition(ko, ConditionTypeDeletionProgressing, corev1.ConditionTrue, deletionReasonWaitingForDisassociation, msg)
+		return latest, requeue.NeededAfter(errors.New(msg), requeue.DefaultRequeueAfterDuration)
+	}
+	setCondition(
+		ko, ConditionTypeDeletionProgressing, corev1.ConditionTrue, deletionReasonDeleting,
+		"the rule has no associations left and is being deleted",
+	)
+	return latest, nil
+}
+
+func setCondition(
+	ko *svcapitypes.ResolverRule,
+	conditionType ackv1alpha1.ConditionType,
+	status corev1.ConditionStatus,
+	reason string,
+	message string,
+) {
+	var c *ackv1alpha1.Condition
+	for _, cond := range ko.Status.Conditions {
+		if cond.Type == conditionType {
+			c = cond
+			break
+		}
+	}
+	if c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: conditionType,
+		}
+		ko.Status.Conditions = append(ko.Status.Conditions, c)
+	}
+	if c.Status != status {
+		now := metav1.Now()
+		c.LastTransitionTime = &now
+	}
+	c.Status = status
+	c.Reason = &reason
+	c.Message = &message
 }
 
 func (rm *resourceManager) customUpdateResolverRule(
@@ -72,6 +479,18 @@ func (rm *resourceManager) customUpdateResolverRule(
 	exit := rlog.Trace("rm.customUpdateResolverRule")
 	defer exit(err)
 
+	if isSharedWithMe(latest.ko) {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"resolver rule %s is owned by account %s and shared with this account, "+
+				"it cannot be updated; annotate the resource with %s: \"true\" to observe it instead",
+			lo.FromPtr(latest.ko.Status.ID), lo.FromPtr(latest.ko.Status.OwnerID), ackv1alpha1.AnnotationReadOnly,
+		))
+	}
+	if err = validateImmutableFieldChanges(desired, latest, delta); err != nil {
+		return nil, err
+	}
+	desired.ko.Status.LegacyAssociationVPCs = latest.ko.Status.LegacyAssociationVPCs
+
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
@@ -94,7 +513,10 @@ func (rm *resourceManager) customUpdateResolverRule(
 	// (now updated.Spec) reflects the latest resource state.
 	updated = rm.concreteResource(desired.DeepCopy())
 
-	if delta.DifferentAt("Spec.TargetIPs") || delta.DifferentAt("Spec.Name") {
+	// UpdateResolverRule replaces every field of ResolverRuleConfig, so a
+	// change to any of them sends the whole desired config.
+	if delta.DifferentAt("Spec.TargetIPs") || delta.DifferentAt("Spec.Name") ||
+		delta.DifferentAt("Spec.ResolverEndpointID") {
 		if err := rm.syncResolverRuleConfig(ctx, desired, latest); err != nil {
 			return nil, err
 		}
@@ -103,6 +525,57 @@ func (rm *resourceManager) customUpdateResolverRule(
 	return updated, RequeueOnUpdate
 }
 
+// validateImmutableFieldChanges returns a terminal error when the domain name
+// or the rule type changes. UpdateResolverRule cannot change either of them,
+// so the rule has to be recreated instead.
+func validateImmutableFieldChanges(
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) error {
+	changed := []string{}
+	if delta.DifferentAt("Spec.DomainName") {
+		changed = append(changed, fmt.Sprintf(
+			"domainName from %q to %q",
+			lo.FromPtr(latest.ko.Spec.DomainName), lo.FromPtr(desired.ko.Spec.DomainName),
+		))
+	}
+	if delta.DifferentAt("Spec.RuleType") {
+		changed = append(changed, fmt.Sprintf(
+			"ruleType from %q to %q",
+			lo.FromPtr(latest.ko.Spec.RuleType), lo.FromPtr(desired.ko.Spec.RuleType),
+		))
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"cannot change %s of an existing resolver rule, recreate the rule instead",
+		strings.Join(changed, " and "),
+	))
+}
+
+// customPreCompare compares the fields of the rule that the generated delta
+// cannot. Route 53 Resolver returns the domain name fully qualified, with a
+// trailing dot, so domain names are compared in their normalized form.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if ackcompare.HasNilDifference(a.ko.Spec.DomainName, b.ko.Spec.DomainName) ||
+		normalizeDomainName(a.ko.Spec.DomainName) != normalizeDomainName(b.ko.Spec.DomainName) {
+		delta.Add("Spec.DomainName", a.ko.Spec.DomainName, b.ko.Spec.DomainName)
+	}
+}
+
+// normalizeDomainName returns the domain name without its trailing dot and in
+// lower case, the form in which two domain names are equal for Route 53
+// Resolver.
+func normalizeDomainName(domainName *string) string {
+	return strings.ToLower(strings.TrimSuffix(lo.FromPtr(domainName), "."))
+}
+
 func (rm *resourceManager) createAssociation(
 	ctx context.Context,
 	r *resource,
@@ -115,6 +588,50 @@ func (rm *resourceManager) createAssociation(
 	return nil
 }
 
+// defaultTargetPort is the port Route 53 Resolver uses for a target IP
+// without one.
+const defaultTargetPort = 53
+
+// targetAddressKey identifies a target IP by its address and port.
+func targetAddressKey(tip *svcapitypes.TargetAddress) string {
+	return fmt.Sprintf("%s|%s|%d",
+		lo.FromPtr(tip.IP), lo.FromPtr(tip.IPv6), lo.FromPtrOr(tip.Port, defaultTargetPort))
+}
+
+// normalizeTargetIPs clears the protocol Route 53 Resolver reports for target
+// IPs that leave it unset in the spec, when the reported protocol is the
+// service default, so that the default does not show up as a difference.
+// Target IPs are matched by address and port, and the observed ones are put
+// in the order of the spec, since Route 53 Resolver does not keep the order
+// they were set in.
+func normalizeTargetIPs(
+	desired []*svcapitypes.TargetAddress,
+	observed []*svcapitypes.TargetAddress,
+) {
+	positions := map[string]int{}
+	for i, tip := range desired {
+		if tip != nil {
+			positions[targetAddressKey(tip)] = i
+		}
+	}
+	position := func(tip *svcapitypes.TargetAddress) int {
+		if i, ok := positions[targetAddressKey(tip)]; ok {
+			return i
+		}
+		return len(desired)
+	}
+	for _, tip := range observed {
+		i := position(tip)
+		if i < len(desired) && desired[i].Protocol == nil &&
+			lo.FromPtr(tip.Protocol) == string(svcsdktypes.ProtocolDo53) {
+			tip.Protocol = nil
+		}
+	}
+	sort.SliceStable(observed, func(i, j int) bool {
+		return position(observed[i]) < position(observed[j])
+	})
+}
+
 func (rm *resourceManager) syncResolverRuleConfig(
 	ctx context.Context,
 	desired *resource,
@@ -146,10 +663,15 @@ func (rm *resourceManager) syncResolverRuleConfig(
 			portCopy := int32(*tip.Port)
 			targipelem.Port = &portCopy
 		}
+		if tip.Protocol != nil {
+			targipelem.Protocol = svcsdktypes.Protocol(*tip.Protocol)
+		}
+		if tip.ServerNameIndication != nil {
+			targipelem.ServerNameIndication = tip.ServerNameIndication
+		}
 		targip = append(targip, targipelem)
 	}
 	resconf.TargetIps = targip
-	resconf.Name = desired.ko.Spec.Name
 	input.Config = resconf
 	var resp *svcsdk.UpdateResolverRuleOutput
 	_ = resp
@@ -188,11 +710,18 @@ func (rm *resourceManager) syncAssociation(
 			}
 		}
 	}
+	// Associations owned elsewhere are neither added nor deleted.
+	owner := desired.ko
+	if latest != nil {
+		owner = latest.ko
+	}
+	foreign := lo.Keys(foreignAssociationVPCs(owner))
+
 	// Determining the associations to be added and deleted by comparing associations of latest and desired.
-	toAdd := lo.OmitByKeys(desiredAssociations, lo.Keys(latestAssociations))
+	toAdd := lo.OmitByKeys(desiredAssociations, append(lo.Keys(latestAssociations), foreign...))
 	includedVpcs := lo.PickByKeys(associationidVpc, lo.Keys(desiredAssociations))
 	associations_diff := lo.OmitByKeys(latestAssociations, lo.Keys(desiredAssociations))
-	toDelete := lo.OmitByKeys(associations_diff, lo.Values(includedVpcs))
+	toDelete := lo.OmitByKeys(associations_diff, append(lo.Values(includedVpcs), foreign...))
 
 	upsertErr := rm.upsertNewAssociations(ctx, desired, latest, toAdd)
 	if upsertErr != nil {
@@ -236,6 +765,7 @@ func (rm *resourceManager) upsertNewAssociations(
 	for rid, rtype := range toAdd {
 		input := &svcsdk.AssociateResolverRuleInput{}
 		if rtype == TypeVPCId {
+			input.Name = lo.ToPtr(InlineAssociationName)
 			input.ResolverRuleId = desired.ko.Status.ID
 			input.VPCId = &rid
 			_, err = rm.sdkapi.AssociateResolverRule(ctx, input)
@@ -248,6 +778,13 @@ func (rm *resourceManager) upsertNewAssociations(
 	return nil
 }
 
+// isSharedWithMe returns true if the rule is owned by another account and
+// shared with this one through RAM. Such a rule can be read and associated
+// with VPCs, but its configuration and tags belong to the owning account.
+func isSharedWithMe(ko *svcapitypes.ResolverRule) bool {
+	return lo.FromPtr(ko.Status.ShareStatus) == string(svcsdktypes.ShareStatusSharedWithMe)
+}
+
 // getTags retrieves the resource's associated tags.
 func (rm *resourceManager) getTags(
 	ctx context.Context,
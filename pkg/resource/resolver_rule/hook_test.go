@@ -0,0 +1,148 @@
+package resolver_rule
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/samber/lo"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+)
+
+func newTestRule(domainName string) *resource {
+	return &resource{&svcapitypes.ResolverRule{
+		Spec: svcapitypes.ResolverRuleSpec{
+			DomainName: lo.ToPtr(domainName),
+			RuleType:   lo.ToPtr("FORWARD"),
+		},
+	}}
+}
+
+func TestNewResourceDelta_DomainName(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  string
+		observed string
+		want     bool
+	}{
+		{
+			name:     "trailing dot added by Route 53 Resolver",
+			desired:  "example.com",
+			observed: "example.com.",
+			want:     false,
+		},
+		{
+			name:     "different case",
+			desired:  "Example.COM",
+			observed: "example.com.",
+			want:     false,
+		},
+		{
+			name:     "different domain",
+			desired:  "example.org",
+			observed: "example.com.",
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := newResourceDelta(newTestRule(tt.desired), newTestRule(tt.observed))
+			if got := delta.DifferentAt("Spec.DomainName"); got != tt.want {
+				t.Errorf("expected a difference at Spec.DomainName to be %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeTargetIPs_MatchesByAddress(t *testing.T) {
+	desired := []*svcapitypes.TargetAddress{
+		{IP: lo.ToPtr("10.0.0.10")},
+		{IP: lo.ToPtr("10.0.0.11"), Port: lo.ToPtr(int64(5353)), Protocol: lo.ToPtr("DoH")},
+	}
+	observed := []*svcapitypes.TargetAddress{
+		{IP: lo.ToPtr("10.0.0.11"), Port: lo.ToPtr(int64(5353)), Protocol: lo.ToPtr("DoH")},
+		{IP: lo.ToPtr("10.0.0.10"), Port: lo.ToPtr(int64(53)), Protocol: lo.ToPtr("Do53")},
+	}
+
+	normalizeTargetIPs(desired, observed)
+	if lo.FromPtr(observed[0].IP) != "10.0.0.10" || lo.FromPtr(observed[1].IP) != "10.0.0.11" {
+		t.Fatalf("expected the observed targets in the order of the spec, got %s and %s",
+			lo.FromPtr(observed[0].IP), lo.FromPtr(observed[1].IP))
+	}
+	if observed[0].Protocol != nil {
+		t.Errorf("expected the default protocol to be cleared, got %q", *observed[0].Protocol)
+	}
+	if lo.FromPtr(observed[1].Protocol) != "DoH" {
+		t.Errorf("expected the desired protocol to be kept, got %q", lo.FromPtr(observed[1].Protocol))
+	}
+}
+
+func newTestAssociation(vpc string, name string) *svcapitypes.ResolverRuleAssociation_SDK {
+	association := &svcapitypes.ResolverRuleAssociation_SDK{
+		VPCID:  lo.ToPtr(vpc),
+		Status: lo.ToPtr("COMPLETE"),
+	}
+	if name != "" {
+		association.Name = lo.ToPtr(name)
+	}
+	return association
+}
+
+func newTestAssociatedRule(vpcs ...string) *svcapitypes.ResolverRule {
+	ko := newTestRule("example.com").ko
+	for _, vpc := range vpcs {
+		ko.Spec.Associations = append(ko.Spec.Associations, &svcapitypes.ResolverRuleAssociation_SDK{
+			VPCID: lo.ToPtr(vpc),
+		})
+	}
+	return ko
+}
+
+// Rules created before inline associations were named own unnamed
+// associations, which must keep being managed after an upgrade.
+func TestLegacyAssociations_Upgrade(t *testing.T) {
+	observed := []*svcapitypes.ResolverRuleAssociation_SDK{
+		newTestAssociation("vpc-a", ""),
+		newTestAssociation("vpc-b", ""),
+		newTestAssociation("vpc-c", "owned-elsewhere"),
+		newTestAssociation("vpc-d", ""),
+	}
+
+	desired := newTestAssociatedRule("vpc-a", "vpc-b")
+	ko := desired.DeepCopy()
+	ko.Status.VPCAssociations = observed
+	recordLegacyAssociations(desired, ko)
+	if got := aws.ToStringSlice(ko.Status.LegacyAssociationVPCs); len(got) != 2 || got[0] != "vpc-a" || got[1] != "vpc-b" {
+		t.Fatalf("expected the unnamed associations of spec.associations to be claimed, got %v", got)
+	}
+	foreign := foreignAssociationVPCs(ko)
+	if foreign["vpc-a"] || foreign["vpc-b"] {
+		t.Errorf("expected the claimed associations not to be foreign, got %v", foreign)
+	}
+	if !foreign["vpc-c"] || !foreign["vpc-d"] {
+		t.Errorf("expected the other associations to be foreign, got %v", foreign)
+	}
+	setAssociationConflictCondition(ko, desired.Spec.Associations)
+	for _, cond := range ko.Status.Conditions {
+		if cond.Type == ConditionTypeAssociationConflict && lo.FromPtr(cond.Reason) != associationReasonNoConflict {
+			t.Errorf("expected no conflict on the rule's own associations, got %+v", cond)
+		}
+	}
+
+	// Removing vpc-b from spec.associations still disassociates it.
+	desired = newTestAssociatedRule("vpc-a")
+	desired.Status.LegacyAssociationVPCs = ko.Status.LegacyAssociationVPCs
+	latest := desired.DeepCopy()
+	latest.Status.VPCAssociations = observed
+	recordLegacyAssociations(desired, latest)
+	latest.Spec.Associations = inlineAssociations(desired, latest)
+	vpcs := lo.Map(latest.Spec.Associations, func(a *svcapitypes.ResolverRuleAssociation_SDK, _ int) string {
+		return lo.FromPtr(a.VPCID)
+	})
+	if len(vpcs) != 2 || !lo.Contains(vpcs, "vpc-b") {
+		t.Errorf("expected the removed VPC to be reported for disassociation, got %v", vpcs)
+	}
+	if foreignAssociationVPCs(latest)["vpc-b"] {
+		t.Errorf("expected the removed VPC to stay owned by the rule")
+	}
+}
@@ -64,6 +64,16 @@ func (rm *resourceManager) sdkFind(
 	defer func() {
 		exit(err)
 	}()
+	if r.ko.Status.ID == nil && r.ko.Status.CreatorRequestID != nil {
+		if r, err = rm.findByCreatorRequestId(ctx, r); err != nil {
+			return nil, err
+		}
+	}
+	if r.ko.Spec.AdoptionFilters != nil {
+		if r, err = rm.findByAdoptionFilters(ctx, r); err != nil {
+			return nil, err
+		}
+	}
 	// If any required fields in the input shape are missing, AWS resource is
 	// not created yet. Return NotFound here to indicate to callers that the
 	// resource isn't yet created.
@@ -172,6 +182,12 @@ func (rm *resourceManager) sdkFind(
 				portCopy := int64(*f13iter.Port)
 				f13elem.Port = &portCopy
 			}
+			if f13iter.Protocol != "" {
+				f13elem.Protocol = aws.String(string(f13iter.Protocol))
+			}
+			if f13iter.ServerNameIndication != nil {
+				f13elem.ServerNameIndication = f13iter.ServerNameIndication
+			}
 			f13 = append(f13, f13elem)
 		}
 		ko.Spec.TargetIPs = f13
@@ -180,16 +196,22 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
-	ko.Spec.Associations, err = rm.getAttachedVPC(ctx, &resource{ko})
+	normalizeTargetIPs(r.ko.Spec.TargetIPs, ko.Spec.TargetIPs)
+	ko.Status.VPCAssociations, err = rm.getAttachedVPC(ctx, &resource{ko})
 	if err != nil {
 		return nil, err
 	}
+	recordLegacyAssociations(r.ko, ko)
+	ko.Spec.Associations = inlineAssociations(r.ko, ko)
+	setAssociationConflictCondition(ko, r.ko.Spec.Associations)
 
-	tags, err := rm.getTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
-	if err != nil {
-		return nil, err
+	if !isSharedWithMe(ko) {
+		tags, err := rm.getTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
+		if err != nil {
+			return nil, err
+		}
+		ko.Spec.Tags = tags
 	}
-	ko.Spec.Tags = tags
 
 	return &resource{ko}, nil
 }
@@ -230,16 +252,17 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err = ensureCreatorRequestId(desired); err != nil {
+		return desired, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
 	}
 	// A unique string that identifies the request and that allows failed requests to be
-	// retried without the risk of running the operation twice.
-	// CreatorRequestId can be any unique string, for example, a date/time stamp.
-	// TODO: Name is not sufficient, since a failed request cannot be retried.
-	// We might need to import the `time` package into `sdk.go`
-	input.CreatorRequestId = getCreatorRequestId(desired.ko)
+	// retried without the risk of running the operation twice. It was stored in
+	// status by the pre build hook before this call.
+	input.CreatorRequestId = desired.ko.Status.CreatorRequestID
 
 	var resp *svcsdk.CreateResolverRuleOutput
 	_ = resp
@@ -333,6 +356,12 @@ func (rm *resourceManager) sdkCreate(
 				portCopy := int64(*f13iter.Port)
 				f13elem.Port = &portCopy
 			}
+			if f13iter.Protocol != "" {
+				f13elem.Protocol = aws.String(string(f13iter.Protocol))
+			}
+			if f13iter.ServerNameIndication != nil {
+				f13elem.ServerNameIndication = f13iter.ServerNameIndication
+			}
 			f13 = append(f13, f13elem)
 		}
 		ko.Spec.TargetIPs = f13
@@ -341,6 +370,7 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
+	normalizeTargetIPs(desired.ko.Spec.TargetIPs, ko.Spec.TargetIPs)
 	if len(desired.ko.Spec.Associations) > 0 {
 		ko.Spec.Associations = desired.ko.Spec.Associations
 		if err := rm.createAssociation(ctx, &resource{ko}); err != nil {
@@ -402,6 +432,12 @@ func (rm *resourceManager) newCreateRequestPayload(
 				portCopy := int32(portCopy0)
 				f5elem.Port = &portCopy
 			}
+			if f5iter.Protocol != nil {
+				f5elem.Protocol = svcsdktypes.Protocol(*f5iter.Protocol)
+			}
+			if f5iter.ServerNameIndication != nil {
+				f5elem.ServerNameIndication = f5iter.ServerNameIndication
+			}
 			f5 = append(f5, *f5elem)
 		}
 		res.TargetIps = f5
@@ -431,12 +467,8 @@ func (rm *resourceManager) sdkDelete(
 	defer func() {
 		exit(err)
 	}()
-	if r.ko.Spec.Associations != nil && r.ko.Status.ID != nil {
-		desired := rm.concreteResource(r.DeepCopy())
-		desired.ko.Spec.Associations = nil
-		if err = rm.syncAssociation(ctx, desired, r); err != nil {
-			return nil, err
-		}
+	if r, err = rm.prepareDelete(ctx, r); err != nil {
+		return r, err
 	}
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
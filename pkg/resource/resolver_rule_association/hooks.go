@@ -1 +1,35 @@
 package resolver_rule_association
+
+import (
+	"strings"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+)
+
+const (
+	// defaultAssociationNamePrefix prefixes the name given to associations
+	// created without spec.name. A named association is never considered
+	// owned by the inline associations of a ResolverRule.
+	defaultAssociationNamePrefix = "ack-"
+	// maxAssociationNameLength is the longest name Route 53 Resolver accepts
+	// for an association.
+	maxAssociationNameLength = 64
+)
+
+// defaultAssociationName returns the name used for an association when
+// spec.name is not set. It is built from the name of the object, with every
+// character Route 53 Resolver does not accept replaced by a dash.
+func defaultAssociationName(ko *svcapitypes.ResolverRuleAssociation) *string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '-', r == '_', r == ' ':
+			return r
+		}
+		return '-'
+	}, defaultAssociationNamePrefix+ko.Name)
+	if len(name) > maxAssociationNameLength {
+		name = name[:maxAssociationNameLength]
+	}
+	return &name
+}
@@ -164,6 +164,9 @@ func (rm *resourceManager) sdkCreate(
 	if err != nil {
 		return nil, err
 	}
+	if input.Name == nil {
+		input.Name = defaultAssociationName(desired.ko)
+	}
 
 	var resp *svcsdk.AssociateResolverRuleOutput
 	_ = resp
@@ -0,0 +1,128 @@
+package resolver_rule_policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/samber/lo"
+
+	svcapitypes "github.com/aws-controllers-k8s/route53resolver-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/route53resolver-controller/pkg/policy"
+)
+
+// allowedActions are the only actions a Resolver rule policy can grant.
+// They are also granted when spec.actions is empty.
+var allowedActions = []string{
+	"AssociateResolverRule",
+	"DisassociateResolverRule",
+	"GetResolverRule",
+	"ListResolverRuleAssociations",
+	"ListResolverRules",
+}
+
+// desiredActions returns spec.actions, or every allowed action when it is
+// empty, and a terminal error for an action a Resolver rule policy cannot
+// grant.
+func desiredActions(ko *svcapitypes.ResolverRulePolicy) ([]string, error) {
+	if len(ko.Spec.Actions) == 0 {
+		return allowedActions, nil
+	}
+	actions := []string{}
+	for _, action := range aws.ToStringSlice(ko.Spec.Actions) {
+		if !lo.Contains(allowedActions, action) {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"action %q cannot be granted by a resolver rule policy, use one of %s",
+				action, strings.Join(allowedActions, ", "),
+			))
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// newPolicyDocument builds the Resolver rule policy that grants the accounts
+// in spec.principals the actions in spec.actions on the rule.
+func newPolicyDocument(ko *svcapitypes.ResolverRulePolicy) (*string, error) {
+	actions, err := desiredActions(ko)
+	if err != nil {
+		return nil, err
+	}
+	return policy.New(
+		aws.ToString(ko.Spec.ResolverRuleARN),
+		aws.ToStringSlice(ko.Spec.Principals),
+		actions,
+	)
+}
+
+// customPreCompare compares the policy built from the desired spec with the
+// policy read from AWS, so that accounts or actions changed outside the
+// controller are differences while formatting and ordering are not.
+func customPreCompare(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	actions := aws.ToStringSlice(a.ko.Spec.Actions)
+	if len(actions) == 0 {
+		actions = allowedActions
+	}
+	policy.Compare(
+		delta,
+		policy.Fields{
+			Principals: "Spec.Principals",
+			Actions:    "Spec.Actions",
+			Document:   "Status.ResolverRulePolicy",
+		},
+		aws.ToString(a.ko.Spec.ResolverRuleARN),
+		aws.ToStringSlice(a.ko.Spec.Principals),
+		actions,
+		b.ko.Status.ResolverRulePolicy,
+	)
+}
+
+// setPrincipals sets spec.principals from the policy read from AWS.
+func setPrincipals(
+	desired *svcapitypes.ResolverRulePolicy,
+	ko *svcapitypes.ResolverRulePolicy,
+) error {
+	principals, err := policy.Principals(desired.Spec.Principals, ko.Status.ResolverRulePolicy)
+	if err != nil {
+		return err
+	}
+	ko.Spec.Principals = principals
+	return nil
+}
+
+// customDeleteResolverRulePolicy stops sharing the rule by replacing its
+// policy with one that only grants the account owning it.
+func (rm *resourceManager) customDeleteResolverRulePolicy(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customDeleteResolverRulePolicy")
+	defer func() {
+		exit(err)
+	}()
+
+	if r.ko.Spec.ResolverRuleARN == nil {
+		return nil, nil
+	}
+	document, err := policy.OwnerOnly(*r.ko.Spec.ResolverRuleARN, allowedActions)
+	if err != nil {
+		return nil, err
+	}
+	input := &svcsdk.PutResolverRulePolicyInput{
+		Arn:                r.ko.Spec.ResolverRuleARN,
+		ResolverRulePolicy: document,
+	}
+	_, err = rm.sdkapi.PutResolverRulePolicy(ctx, input)
+	rm.metrics.RecordAPICall("DELETE", "PutResolverRulePolicy", err)
+	return nil, policy.IgnoreNotFound(err)
+}
@@ -0,0 +1,3 @@
+	if err = validateDomainSource(desired); err != nil {
+		return nil, err
+	}
@@ -0,0 +1,18 @@
+	if r.ko.Spec.Domains != nil {
+		domains, err := rm.listDomains(ctx, ko.Status.ID)
+		if err != nil {
+			return nil, err
+		}
+		ko.Spec.Domains = domains
+	}
+	ko.Spec.DomainFileURL = ko.Status.ImportedDomainFileURL
+	if !r.IsBeingDeleted() {
+		if err = importFailedError(r, &resource{ko}); err != nil {
+			return &resource{ko}, err
+		}
+	}
+	tags, err := rm.getTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
+	if err != nil {
+		return nil, err
+	}
+	ko.Spec.Tags = tags
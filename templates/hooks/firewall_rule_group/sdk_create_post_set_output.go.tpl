@@ -0,0 +1,5 @@
+	if ko.Spec.Rules != nil {
+		if err = rm.syncRules(ctx, &resource{ko}, nil); err != nil {
+			return &resource{ko}, err
+		}
+	}
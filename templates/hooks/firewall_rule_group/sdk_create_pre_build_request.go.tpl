@@ -0,0 +1,3 @@
+	if err = validateRules(desired); err != nil {
+		return nil, err
+	}
@@ -0,0 +1,7 @@
+	if r.ko.Spec.Rules != nil && r.ko.Status.ID != nil {
+		desired := rm.concreteResource(r.DeepCopy())
+		desired.ko.Spec.Rules = nil
+		if err = rm.syncRules(ctx, desired, r); err != nil {
+			return nil, err
+		}
+	}
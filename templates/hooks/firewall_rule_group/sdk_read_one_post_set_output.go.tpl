@@ -0,0 +1,11 @@
+	rules, err := rm.listRules(ctx, ko.Status.ID)
+	if err != nil {
+		return nil, err
+	}
+	setRuleReferences(rules, r.ko.Spec.Rules)
+	ko.Spec.Rules = rules
+	tags, err := rm.getTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
+	if err != nil {
+		return nil, err
+	}
+	ko.Spec.Tags = tags
@@ -0,0 +1,11 @@
+	if isUpdating(latest) {
+		return nil, RequeueWhileUpdating
+	}
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if !delta.DifferentExcept("Spec.Tags") {
+		return desired, nil
+	}
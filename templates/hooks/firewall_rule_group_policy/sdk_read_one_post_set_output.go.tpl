@@ -0,0 +1,6 @@
+	if err = setPrincipals(r.ko, ko); err != nil {
+		return nil, err
+	}
+	if err = rm.setShareStatus(ctx, ko); err != nil {
+		return nil, err
+	}
@@ -0,0 +1,4 @@
+	input.FirewallRuleGroupPolicy, err = newPolicyDocument(desired.ko)
+	if err != nil {
+		return nil, err
+	}
@@ -0,0 +1,4 @@
+	// A unique string that identifies the request and that allows failed requests to be
+	// retried without the risk of running the operation twice. It was stored in
+	// status by the pre build hook before this call.
+	input.CreatorRequestId = desired.ko.Status.CreatorRequestID
@@ -0,0 +1,10 @@
+	tags, err := rm.getTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
+	if err != nil {
+		return nil, err
+	}
+	ko.Spec.Tags = tags
+	if !r.IsBeingDeleted() {
+		if err = failedStatusError(&resource{ko}); err != nil {
+			return &resource{ko}, err
+		}
+	}
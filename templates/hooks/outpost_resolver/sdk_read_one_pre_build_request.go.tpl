@@ -0,0 +1,5 @@
+	if r.ko.Status.ID == nil && r.ko.Status.CreatorRequestID != nil {
+		if r, err = rm.findByCreatorRequestId(ctx, r); err != nil {
+			return nil, err
+		}
+	}
@@ -0,0 +1 @@
+	setAutodefinedReverseFlag(ko)
@@ -0,0 +1 @@
+	setValidation(ko)
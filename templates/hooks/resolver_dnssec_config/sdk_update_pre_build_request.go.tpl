@@ -0,0 +1,3 @@
+	if isTransitioning(latest) {
+		return nil, RequeueWhileTransitioning
+	}
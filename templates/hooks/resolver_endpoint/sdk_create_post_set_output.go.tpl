@@ -1 +1,4 @@
-	rm.ListAttachedIPAddresses(ctx, ko)
\ No newline at end of file
+	if err = rm.ListAttachedIPAddresses(ctx, ko); err != nil {
+		return &resource{ko}, err
+	}
+	setIPAddressesCondition(ko, desired.ko.Spec.IPAddresses)
@@ -0,0 +1,9 @@
+	if err = validateProtocols(desired); err != nil {
+		return nil, err
+	}
+	if err = validateOutpost(desired); err != nil {
+		return nil, err
+	}
+	if err = ensureCreatorRequestId(desired); err != nil {
+		return desired, err
+	}
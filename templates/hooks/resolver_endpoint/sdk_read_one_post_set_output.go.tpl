@@ -1,4 +1,10 @@
-	rm.ListAttachedIPAddresses(ctx, ko)
+	if err = rm.ListAttachedIPAddresses(ctx, ko); err != nil {
+		return nil, err
+	}
+	recordFailedIPAddresses(r, ko)
+	setIPAddressesCondition(ko, r.ko.Spec.IPAddresses)
+	setRemediationCondition(ko)
+	setDualStackMigrationCondition(ko, r.ko.Spec.ResolverEndpointType)
 
 	tags, err := rm.getTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
 	if err != nil {
@@ -0,0 +1,5 @@
+	if isDualStackMigration(desired, latest) {
+		if input.UpdateIpAddresses, err = rm.newDualStackIPAddresses(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
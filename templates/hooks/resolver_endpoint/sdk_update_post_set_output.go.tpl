@@ -1,4 +1,8 @@
-	if delta.DifferentAt("Spec.IPAddresses") {
-		rm.SyncIPAddresses(ctx, desired, latest)
+	if delta.DifferentAt("Spec.IPAddresses") && !isRemediating(latest.ko) && !isDualStackMigration(desired, latest) {
+		if err = rm.SyncIPAddresses(ctx, desired, latest); err != nil {
+			return nil, err
+		}
 		ko.Status.IPAddressCount = latest.ko.Status.IPAddressCount
-	}
\ No newline at end of file
+		setIPAddressesPending(ko)
+	}
+	setDualStackMigrationCondition(ko, desired.ko.Spec.ResolverEndpointType)
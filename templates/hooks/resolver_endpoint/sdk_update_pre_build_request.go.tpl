@@ -1,7 +1,28 @@
+	// The IP address state and conditions are kept in status by sdkFind, so
+	// they have to survive the update, which starts from a copy of desired.
+	copyIPAddressStatus(desired.ko, latest.ko)
+	if delta.DifferentAt("Status.IPAddressRemediations") && canRemediate(desired) {
+		if err = rm.remediateIPAddresses(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		setRemediationCondition(desired.ko)
+	}
+	if err = validateProtocols(desired); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.ResolverEndpointType") {
+		if err = validateEndpointTypeChange(desired, latest); err != nil {
+			return nil, err
+		}
+		if isUpdating(latest) {
+			return nil, RequeueWhileUpdating
+		}
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
 		}
-	} else if !delta.DifferentExcept("Spec.Tags") {
+	}
+	if !delta.DifferentExcept("Spec.Tags", "Status.IPAddressRemediations") {
 		return desired, nil
 	}
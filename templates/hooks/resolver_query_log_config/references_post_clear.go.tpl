@@ -0,0 +1,3 @@
+	if ko.Spec.DestinationRef != nil {
+		ko.Spec.DestinationARN = nil
+	}
@@ -0,0 +1,3 @@
+	if resolved, hasReferences, err := rm.resolveDestinationReference(ctx, apiReader, res); hasReferences || err != nil {
+		return resolved, hasReferences, err
+	}
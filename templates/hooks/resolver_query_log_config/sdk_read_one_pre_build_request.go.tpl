@@ -0,0 +1,5 @@
+	if r.ko.Spec.AdoptionFilters != nil {
+		if r, err = rm.findByAdoptionFilters(ctx, r); err != nil {
+			return nil, err
+		}
+	}
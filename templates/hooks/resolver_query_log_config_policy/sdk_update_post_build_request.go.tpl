@@ -0,0 +1,4 @@
+	input.ResolverQueryLogConfigPolicy, err = newPolicyDocument(desired.ko)
+	if err != nil {
+		return nil, err
+	}
@@ -1,3 +1,4 @@
+	normalizeTargetIPs(desired.ko.Spec.TargetIPs, ko.Spec.TargetIPs)
 	if len(desired.ko.Spec.Associations) > 0 {
 		ko.Spec.Associations = desired.ko.Spec.Associations
 		if err := rm.createAssociation(ctx, &resource{ko}); err != nil {
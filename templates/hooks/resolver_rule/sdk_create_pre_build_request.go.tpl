@@ -0,0 +1,3 @@
+	if err = ensureCreatorRequestId(desired); err != nil {
+		return desired, err
+	}
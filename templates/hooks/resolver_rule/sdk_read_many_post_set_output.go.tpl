@@ -1,10 +1,16 @@
-	ko.Spec.Associations, err = rm.getAttachedVPC(ctx,&resource{ko})
+	normalizeTargetIPs(r.ko.Spec.TargetIPs, ko.Spec.TargetIPs)
+	ko.Status.VPCAssociations, err = rm.getAttachedVPC(ctx, &resource{ko})
 	if err != nil {
 		return nil, err
 	}
+	recordLegacyAssociations(r.ko, ko)
+	ko.Spec.Associations = inlineAssociations(r.ko, ko)
+	setAssociationConflictCondition(ko, r.ko.Spec.Associations)
 	
-	tags, err := rm.getTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
-	if err != nil {
-		return nil, err
+	if !isSharedWithMe(ko) {
+		tags, err := rm.getTags(ctx, string(*ko.Status.ACKResourceMetadata.ARN))
+		if err != nil {
+			return nil, err
+		}
+		ko.Spec.Tags = tags
 	}
-	ko.Spec.Tags = tags
@@ -0,0 +1,10 @@
+	if r.ko.Status.ID == nil && r.ko.Status.CreatorRequestID != nil {
+		if r, err = rm.findByCreatorRequestId(ctx, r); err != nil {
+			return nil, err
+		}
+	}
+	if r.ko.Spec.AdoptionFilters != nil {
+		if r, err = rm.findByAdoptionFilters(ctx, r); err != nil {
+			return nil, err
+		}
+	}
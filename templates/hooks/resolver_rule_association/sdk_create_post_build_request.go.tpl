@@ -0,0 +1,3 @@
+	if input.Name == nil {
+		input.Name = defaultAssociationName(desired.ko)
+	}
@@ -0,0 +1,3 @@
+	if err = setPrincipals(r.ko, ko); err != nil {
+		return nil, err
+	}
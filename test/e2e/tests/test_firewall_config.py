@@ -0,0 +1,99 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the Route53 Resolver FirewallConfig resource
+"""
+
+import logging
+import time
+
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_route53resolver_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.bootstrap_resources import get_bootstrap_resources
+
+RESOURCE_PLURAL = "firewallconfigs"
+
+UPDATE_WAIT_AFTER_SECONDS = 10
+
+
+def get_fail_open(route53resolver_client, vpc_id):
+    return route53resolver_client.get_firewall_config(
+        ResourceId=vpc_id
+    )["FirewallConfig"]["FirewallFailOpen"]
+
+
+@pytest.fixture
+def firewall_config():
+    config_name = random_suffix_name("firewall-config-test", 32)
+    vpc_id = get_bootstrap_resources().AssociationTestVPC.vpc_id
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["FIREWALL_CONFIG_NAME"] = config_name
+    replacements["VPC_ID"] = vpc_id
+
+    resource_data = load_route53resolver_resource(
+        "firewall_config",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        config_name, namespace="default",
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr, vpc_id)
+
+    try:
+        if k8s.get_resource_exists(ref):
+            k8s.delete_custom_resource(ref, 3, 10)
+    except Exception as e:
+        logging.warning(f"Cleanup failed for {config_name}: {e}")
+
+
+@service_marker
+class TestFirewallConfig:
+    def test_set_and_reset_fail_open(self, route53resolver_client, firewall_config):
+        (ref, cr, vpc_id) = firewall_config
+
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+        cr = k8s.get_resource(ref)
+        assert cr["status"]["id"] is not None
+        assert get_fail_open(route53resolver_client, vpc_id) == "ENABLED"
+
+        updates = {"spec": {"firewallFailOpen": "DISABLED"}}
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(UPDATE_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+        condition.assert_synced(ref)
+        assert get_fail_open(route53resolver_client, vpc_id) == "DISABLED"
+
+        updates = {"spec": {"firewallFailOpen": "ENABLED"}}
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(UPDATE_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+        assert get_fail_open(route53resolver_client, vpc_id) == "ENABLED"
+
+        # Deleting the CR resets the VPC back to the AWS default.
+        _, deleted = k8s.delete_custom_resource(ref, 6, 10)
+        assert deleted
+        assert get_fail_open(route53resolver_client, vpc_id) == "DISABLED"
@@ -0,0 +1,140 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+import logging
+import time
+
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_route53resolver_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+
+RESOURCE_PLURAL = "firewalldomainlists"
+
+SYNC_TIMEOUT_SECONDS = 180
+
+
+def wait_for_complete(ref, timeout=SYNC_TIMEOUT_SECONDS):
+    deadline = time.time() + timeout
+    while time.time() < deadline:
+        cr = k8s.get_resource(ref)
+        if cr and cr.get("status", {}).get("status") == "COMPLETE":
+            return cr
+        time.sleep(10)
+    pytest.fail(f"FirewallDomainList {ref.name} did not reach COMPLETE within {timeout}s")
+
+
+def list_domains(route53resolver_client, domain_list_id):
+    paginator = route53resolver_client.get_paginator("list_firewall_domains")
+    domains = []
+    for page in paginator.paginate(FirewallDomainListId=domain_list_id):
+        domains.extend(page["Domains"])
+    return sorted(d.rstrip(".") for d in domains)
+
+
+@pytest.fixture
+def firewall_domain_list(route53resolver_client):
+    domain_list_name = random_suffix_name("fdl-test", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["FIREWALL_DOMAIN_LIST_NAME"] = domain_list_name
+
+    resource_data = load_route53resolver_resource(
+        "firewall_domain_list",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        domain_list_name, namespace="default",
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    try:
+        if k8s.get_resource_exists(ref):
+            k8s.delete_custom_resource(ref, 3, 10)
+    except Exception as e:
+        logging.warning(f"Cleanup failed for {domain_list_name}: {e}")
+
+
+@service_marker
+class TestFirewallDomainList:
+    @pytest.mark.canary
+    def test_create_update_delete(self, route53resolver_client, firewall_domain_list):
+        (ref, cr) = firewall_domain_list
+
+        cr = wait_for_complete(ref)
+        domain_list_id = cr["status"]["id"]
+        assert domain_list_id is not None
+
+        condition.assert_synced(ref)
+
+        assert list_domains(route53resolver_client, domain_list_id) == [
+            "*.example.org",
+            "example.com",
+        ]
+
+        updates = {
+            "spec": {
+                "domains": ["example.com", "example.net"],
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(15)
+        wait_for_complete(ref)
+        condition.assert_synced(ref)
+
+        assert list_domains(route53resolver_client, domain_list_id) == [
+            "example.com",
+            "example.net",
+        ]
+
+        _, deleted = k8s.delete_custom_resource(ref, 12, 10)
+        assert deleted
+
+        deleted_in_aws = False
+        for _ in range(9):
+            try:
+                route53resolver_client.get_firewall_domain_list(
+                    FirewallDomainListId=domain_list_id
+                )
+                time.sleep(10)
+            except route53resolver_client.exceptions.ResourceNotFoundException:
+                deleted_in_aws = True
+                break
+
+        assert deleted_in_aws
+
+    def test_conflicting_sources_is_terminal(self, firewall_domain_list):
+        (ref, _) = firewall_domain_list
+
+        wait_for_complete(ref)
+
+        updates = {
+            "spec": {
+                "domainFileURL": "s3://example-bucket/domains.txt",
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(15)
+
+        condition.assert_type_status(ref, condition.CONDITION_TYPE_TERMINAL, True)
@@ -0,0 +1,176 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+import logging
+import time
+
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_route53resolver_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+
+DOMAIN_LIST_PLURAL = "firewalldomainlists"
+RESOURCE_PLURAL = "firewallrulegroups"
+
+SYNC_TIMEOUT_SECONDS = 180
+
+
+def wait_for_complete(ref, timeout=SYNC_TIMEOUT_SECONDS):
+    deadline = time.time() + timeout
+    while time.time() < deadline:
+        cr = k8s.get_resource(ref)
+        if cr and cr.get("status", {}).get("status") == "COMPLETE":
+            return cr
+        time.sleep(10)
+    pytest.fail(f"{ref.plural} {ref.name} did not reach COMPLETE within {timeout}s")
+
+
+def list_rules(route53resolver_client, rule_group_id):
+    paginator = route53resolver_client.get_paginator("list_firewall_rules")
+    rules = []
+    for page in paginator.paginate(FirewallRuleGroupId=rule_group_id):
+        rules.extend(page["FirewallRules"])
+    return {r["Name"]: r for r in rules}
+
+
+@pytest.fixture
+def firewall_domain_list():
+    domain_list_name = random_suffix_name("frg-fdl-test", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["FIREWALL_DOMAIN_LIST_NAME"] = domain_list_name
+
+    resource_data = load_route53resolver_resource(
+        "firewall_domain_list",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, DOMAIN_LIST_PLURAL,
+        domain_list_name, namespace="default",
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    wait_for_complete(ref)
+
+    yield (ref, cr)
+
+    try:
+        if k8s.get_resource_exists(ref):
+            k8s.delete_custom_resource(ref, 3, 10)
+    except Exception as e:
+        logging.warning(f"Cleanup failed for {domain_list_name}: {e}")
+
+
+@pytest.fixture
+def firewall_rule_group(firewall_domain_list):
+    (domain_list_ref, _) = firewall_domain_list
+    rule_group_name = random_suffix_name("frg-test", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["FIREWALL_RULE_GROUP_NAME"] = rule_group_name
+    replacements["FIREWALL_DOMAIN_LIST_NAME"] = domain_list_ref.name
+
+    resource_data = load_route53resolver_resource(
+        "firewall_rule_group",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        rule_group_name, namespace="default",
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr, domain_list_ref)
+
+    try:
+        if k8s.get_resource_exists(ref):
+            k8s.delete_custom_resource(ref, 3, 10)
+    except Exception as e:
+        logging.warning(f"Cleanup failed for {rule_group_name}: {e}")
+
+
+@service_marker
+class TestFirewallRuleGroup:
+    @pytest.mark.canary
+    def test_create_update_delete(self, route53resolver_client, firewall_rule_group):
+        (ref, cr, domain_list_ref) = firewall_rule_group
+
+        cr = wait_for_complete(ref)
+        rule_group_id = cr["status"]["id"]
+        assert rule_group_id is not None
+        condition.assert_synced(ref)
+
+        domain_list_id = k8s.get_resource(domain_list_ref)["status"]["id"]
+
+        rules = list_rules(route53resolver_client, rule_group_id)
+        assert list(rules) == ["block-example"]
+        assert rules["block-example"]["Action"] == "BLOCK"
+        assert rules["block-example"]["Priority"] == 100
+        assert rules["block-example"]["FirewallDomainListId"] == domain_list_id
+
+        updates = {
+            "spec": {
+                "rules": [
+                    {
+                        "name": "block-example",
+                        "priority": 200,
+                        "action": "ALERT",
+                        "firewallDomainListRef": {
+                            "from": {"name": domain_list_ref.name},
+                        },
+                    },
+                ]
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(15)
+        wait_for_complete(ref)
+        condition.assert_synced(ref)
+
+        rules = list_rules(route53resolver_client, rule_group_id)
+        assert rules["block-example"]["Action"] == "ALERT"
+        assert rules["block-example"]["Priority"] == 200
+
+        updates = {"spec": {"rules": []}}
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(15)
+        wait_for_complete(ref)
+
+        assert list_rules(route53resolver_client, rule_group_id) == {}
+
+        _, deleted = k8s.delete_custom_resource(ref, 12, 10)
+        assert deleted
+
+        deleted_in_aws = False
+        for _ in range(9):
+            try:
+                route53resolver_client.get_firewall_rule_group(
+                    FirewallRuleGroupId=rule_group_id
+                )
+                time.sleep(10)
+            except route53resolver_client.exceptions.ResourceNotFoundException:
+                deleted_in_aws = True
+                break
+
+        assert deleted_in_aws
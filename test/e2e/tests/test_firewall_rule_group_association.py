@@ -0,0 +1,153 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the Route53 Resolver FirewallRuleGroupAssociation resource
+"""
+
+import logging
+import time
+
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_route53resolver_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.bootstrap_resources import get_bootstrap_resources
+
+RULE_GROUP_PLURAL = "firewallrulegroups"
+RESOURCE_PLURAL = "firewallrulegroupassociations"
+
+SYNC_TIMEOUT_SECONDS = 300
+
+
+def wait_for_complete(ref, timeout=SYNC_TIMEOUT_SECONDS):
+    deadline = time.time() + timeout
+    while time.time() < deadline:
+        cr = k8s.get_resource(ref)
+        if cr and cr.get("status", {}).get("status") == "COMPLETE":
+            return cr
+        time.sleep(10)
+    pytest.fail(f"{ref.plural} {ref.name} did not reach COMPLETE within {timeout}s")
+
+
+@pytest.fixture
+def firewall_rule_group():
+    rule_group_name = random_suffix_name("frga-frg-test", 32)
+
+    resource_data = {
+        "apiVersion": f"{CRD_GROUP}/{CRD_VERSION}",
+        "kind": "FirewallRuleGroup",
+        "metadata": {"name": rule_group_name},
+        "spec": {"name": rule_group_name},
+    }
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RULE_GROUP_PLURAL,
+        rule_group_name, namespace="default",
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    wait_for_complete(ref)
+
+    yield ref
+
+    try:
+        if k8s.get_resource_exists(ref):
+            k8s.delete_custom_resource(ref, 3, 10)
+    except Exception as e:
+        logging.warning(f"Cleanup failed for {rule_group_name}: {e}")
+
+
+@pytest.fixture
+def firewall_rule_group_association(firewall_rule_group):
+    association_name = random_suffix_name("frga-test", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["FIREWALL_RULE_GROUP_ASSOCIATION_NAME"] = association_name
+    replacements["FIREWALL_RULE_GROUP_NAME"] = firewall_rule_group.name
+    replacements["VPC_ID"] = get_bootstrap_resources().AssociationTestVPC.vpc_id
+
+    resource_data = load_route53resolver_resource(
+        "firewall_rule_group_association",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        association_name, namespace="default",
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr, firewall_rule_group)
+
+    try:
+        if k8s.get_resource_exists(ref):
+            k8s.patch_custom_resource(ref, {"spec": {"mutationProtection": "DISABLED"}})
+            k8s.delete_custom_resource(ref, 3, 10)
+    except Exception as e:
+        logging.warning(f"Cleanup failed for {association_name}: {e}")
+
+
+@service_marker
+class TestFirewallRuleGroupAssociation:
+    @pytest.mark.canary
+    def test_create_update_delete(self, route53resolver_client, firewall_rule_group_association):
+        (ref, cr, rule_group_ref) = firewall_rule_group_association
+
+        cr = wait_for_complete(ref)
+        association_id = cr["status"]["id"]
+        assert association_id is not None
+        condition.assert_synced(ref)
+
+        rule_group_id = k8s.get_resource(rule_group_ref)["status"]["id"]
+        aws_res = route53resolver_client.get_firewall_rule_group_association(
+            FirewallRuleGroupAssociationId=association_id
+        )["FirewallRuleGroupAssociation"]
+        assert aws_res["FirewallRuleGroupId"] == rule_group_id
+        assert aws_res["Priority"] == 101
+        assert aws_res["MutationProtection"] == "DISABLED"
+
+        updates = {"spec": {"priority": 202}}
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(15)
+        wait_for_complete(ref)
+        condition.assert_synced(ref)
+
+        aws_res = route53resolver_client.get_firewall_rule_group_association(
+            FirewallRuleGroupAssociationId=association_id
+        )["FirewallRuleGroupAssociation"]
+        assert aws_res["Priority"] == 202
+
+        _, deleted = k8s.delete_custom_resource(ref, 18, 10)
+        assert deleted
+
+        deleted_in_aws = False
+        for _ in range(18):
+            try:
+                route53resolver_client.get_firewall_rule_group_association(
+                    FirewallRuleGroupAssociationId=association_id
+                )
+                time.sleep(10)
+            except route53resolver_client.exceptions.ResourceNotFoundException:
+                deleted_in_aws = True
+                break
+
+        assert deleted_in_aws
@@ -0,0 +1,113 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+
+"""Integration tests for the Route53 Resolver OutpostResolver resource
+"""
+
+import logging
+import os
+import time
+
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_route53resolver_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+
+RESOURCE_PLURAL = "outpostresolvers"
+
+# Resolvers on Outposts can only be created on a real Outpost, which the
+# default test account does not have.
+OUTPOST_ARN = os.environ.get("ROUTE53RESOLVER_OUTPOST_ARN")
+PREFERRED_INSTANCE_TYPE = os.environ.get("ROUTE53RESOLVER_OUTPOST_INSTANCE_TYPE", "m5.large")
+
+SYNC_TIMEOUT_SECONDS = 1800
+
+
+def wait_for_operational(ref, timeout=SYNC_TIMEOUT_SECONDS):
+    deadline = time.time() + timeout
+    while time.time() < deadline:
+        cr = k8s.get_resource(ref)
+        if cr and cr.get("status", {}).get("status") == "OPERATIONAL":
+            return cr
+        time.sleep(30)
+    pytest.fail(f"{ref.plural} {ref.name} did not reach OPERATIONAL within {timeout}s")
+
+
+@pytest.fixture
+def outpost_resolver():
+    resolver_name = random_suffix_name("outpost-resolver", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["OUTPOST_RESOLVER_NAME"] = resolver_name
+    replacements["OUTPOST_ARN"] = OUTPOST_ARN
+    replacements["PREFERRED_INSTANCE_TYPE"] = PREFERRED_INSTANCE_TYPE
+
+    resource_data = load_route53resolver_resource(
+        "outpost_resolver",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        resolver_name, namespace="default",
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    try:
+        if k8s.get_resource_exists(ref):
+            k8s.delete_custom_resource(ref, 60, 30)
+    except Exception as e:
+        logging.warning(f"Cleanup failed for {resolver_name}: {e}")
+
+
+@service_marker
+@pytest.mark.skipif(OUTPOST_ARN is None, reason="ROUTE53RESOLVER_OUTPOST_ARN is not set")
+class TestOutpostResolver:
+    def test_create_update_delete(self, route53resolver_client, outpost_resolver):
+        (ref, cr) = outpost_resolver
+
+        cr = wait_for_operational(ref)
+        resolver_id = cr["status"]["id"]
+        assert resolver_id is not None
+        condition.assert_synced(ref)
+
+        aws_res = route53resolver_client.get_outpost_resolver(Id=resolver_id)["OutpostResolver"]
+        assert aws_res["OutpostArn"] == OUTPOST_ARN
+        assert aws_res["InstanceCount"] == 4
+
+        # The CreatorRequestId is derived from the UID and generation of the
+        # object that was created.
+        assert cr["status"]["creatorRequestID"] == f"{cr['metadata']['uid']}-1"
+        assert aws_res["CreatorRequestId"] == cr["status"]["creatorRequestID"]
+
+        updates = {"spec": {"instanceCount": 6}}
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(30)
+        wait_for_operational(ref)
+        condition.assert_synced(ref)
+
+        aws_res = route53resolver_client.get_outpost_resolver(Id=resolver_id)["OutpostResolver"]
+        assert aws_res["InstanceCount"] == 6
+
+        _, deleted = k8s.delete_custom_resource(ref, 60, 30)
+        assert deleted
@@ -15,6 +15,7 @@
 """
 
 import boto3
+import ipaddress
 import logging
 import time
 from typing import Dict
@@ -139,6 +140,54 @@ def resolver_endpoint_adopt():
     assert deleted
 
 
+@pytest.fixture
+def resolver_endpoint_filter_adopt():
+    resolver_endpoint = random_suffix_name("resolver-endpoint", 32)
+    security_group_id = get_security_group(get_bootstrap_resources().ResolverEndpointVPC.vpc_id)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["RESOLVER_NAME"] = resolver_endpoint
+    replacements["DIRECTION"] = "OUTBOUND"
+    replacements["SUBNET_1"] = get_bootstrap_resources().ResolverEndpointVPC.private_subnets.subnet_ids[0]
+    replacements["SUBNET_2"] = get_bootstrap_resources().ResolverEndpointVPC.private_subnets.subnet_ids[1]
+    replacements["SECURITY_GROUP"] = security_group_id
+    replacements["DELETION_POLICY"] = "retain"
+
+    resource_data = load_route53resolver_resource(
+        "resolver_endpoint",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        resolver_endpoint, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=30)
+    resolver_id = k8s.get_resource(ref)["status"]["id"]
+
+    # Delete with retain policy, then adopt the endpoint again by name and tag
+    _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+    assert deleted
+
+    resource_data = load_route53resolver_resource(
+        "resolver_endpoint_filter_adoption",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+    assert cr is not None
+
+    yield (ref, resolver_id)
+
+    # The adopted endpoint is retained, so delete it directly
+    _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+    assert deleted
+    boto3.client("route53resolver").delete_resolver_endpoint(ResolverEndpointId=resolver_id)
+
+
 def get_security_group(vpc_id: str) -> str:
     ec2_client = boto3.client("ec2")
     filters = [{'Name': 'vpc-id', 'Values': [vpc_id]}]
@@ -227,3 +276,302 @@ class TestResolverEndpoint:
         except route53resolver_client.exceptions.ResourceNotFoundException:
             pytest.fail(f"Could not find Resolver Endpoint with ID '{resolver_endpoint_id}' in Route53")
 
+
+    def test_update_protocols(self, route53resolver_client, resolver_endpoint):
+        (ref, cr) = resolver_endpoint
+
+        resolver_endpoint_id = cr["status"]["id"]
+        assert resolver_endpoint_id
+        wait_for_operational(route53resolver_client, resolver_endpoint_id)
+
+        aws_res = route53resolver_client.get_resolver_endpoint(ResolverEndpointId=resolver_endpoint_id)
+        assert aws_res["ResolverEndpoint"]["Protocols"] == ["Do53"]
+
+        # Unset protocols are late initialized from the endpoint.
+        cr = k8s.get_resource(ref)
+        assert cr["spec"]["protocols"] == ["Do53"]
+
+        updates = {
+            "spec": {
+                "protocols": ["Do53", "DoH"]
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        wait_for_operational(route53resolver_client, resolver_endpoint_id)
+
+        aws_res = route53resolver_client.get_resolver_endpoint(ResolverEndpointId=resolver_endpoint_id)
+        assert sorted(aws_res["ResolverEndpoint"]["Protocols"]) == ["Do53", "DoH"]
+
+    def test_outbound_doh_fips_is_terminal(self, resolver_endpoint):
+        (ref, cr) = resolver_endpoint
+
+        updates = {
+            "spec": {
+                "protocols": ["DoH-FIPS"]
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+
+        assert k8s.wait_on_condition(ref, "ACK.Terminal", "True", wait_periods=5)
+
+    def test_outpost_without_instance_type_is_terminal(self):
+        resolver_endpoint = random_suffix_name("outpost-endpoint", 32)
+        security_group_id = get_security_group(get_bootstrap_resources().ResolverEndpointVPC.vpc_id)
+
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["RESOLVER_NAME"] = resolver_endpoint
+        replacements["DIRECTION"] = "OUTBOUND"
+        replacements["SUBNET_1"] = get_bootstrap_resources().ResolverEndpointVPC.private_subnets.subnet_ids[0]
+        replacements["SUBNET_2"] = get_bootstrap_resources().ResolverEndpointVPC.private_subnets.subnet_ids[1]
+        replacements["SECURITY_GROUP"] = security_group_id
+        replacements["DELETION_POLICY"] = "delete"
+
+        resource_data = load_route53resolver_resource(
+            "resolver_endpoint",
+            additional_replacements=replacements,
+        )
+        # preferredInstanceType is required together with outpostARN.
+        resource_data["spec"]["outpostARN"] = "arn:aws:outposts:us-west-2:123456789012:outpost/op-0123456789abcdef0"
+        logging.debug(resource_data)
+
+        ref = k8s.CustomResourceReference(
+            CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+            resolver_endpoint, namespace="default",
+        )
+        k8s.create_custom_resource(ref, resource_data)
+        cr = k8s.wait_resource_consumed_by_controller(ref)
+        assert cr is not None
+
+        assert k8s.wait_on_condition(ref, "ACK.Terminal", "True", wait_periods=5)
+
+        cr = k8s.get_resource(ref)
+        assert "id" not in cr["status"]
+
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+
+    def test_pinned_ips_in_same_subnet(self, route53resolver_client, resolver_endpoint):
+        (ref, cr) = resolver_endpoint
+        resolver_endpoint_id = cr["status"]["id"]
+        wait_for_operational(route53resolver_client, resolver_endpoint_id)
+
+        subnet_1 = get_bootstrap_resources().ResolverEndpointVPC.private_subnets.subnet_ids[0]
+        subnet_2 = get_bootstrap_resources().ResolverEndpointVPC.private_subnets.subnet_ids[1]
+        pinned_ip = get_free_ip(subnet_1, offset=50)
+
+        # Two addresses in the first subnet, one of them pinned.
+        updates = {
+            "spec": {
+                "ipAddresses": [
+                    {"subnetID": subnet_1},
+                    {"subnetID": subnet_1, "ip": pinned_ip},
+                    {"subnetID": subnet_2},
+                ]
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        wait_for_operational(route53resolver_client, resolver_endpoint_id)
+
+        ips = route53resolver_client.list_resolver_endpoint_ip_addresses(
+            ResolverEndpointId=resolver_endpoint_id,
+        )["IpAddresses"]
+        assert len(ips) == 3
+        assert len([ip for ip in ips if ip["SubnetId"] == subnet_1]) == 2
+        assert pinned_ip in [ip["Ip"] for ip in ips]
+
+        # Changing the pinned address replaces only that address.
+        new_pinned_ip = get_free_ip(subnet_1, offset=51)
+        updates["spec"]["ipAddresses"][1]["ip"] = new_pinned_ip
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        wait_for_operational(route53resolver_client, resolver_endpoint_id)
+
+        ips = route53resolver_client.list_resolver_endpoint_ip_addresses(
+            ResolverEndpointId=resolver_endpoint_id,
+        )["IpAddresses"]
+        assert len(ips) == 3
+        assert new_pinned_ip in [ip["Ip"] for ip in ips]
+        assert pinned_ip not in [ip["Ip"] for ip in ips]
+
+    def test_ip_addresses_healthy_condition(self, route53resolver_client, resolver_endpoint):
+        (ref, cr) = resolver_endpoint
+        resolver_endpoint_id = cr["status"]["id"]
+        wait_for_operational(route53resolver_client, resolver_endpoint_id)
+
+        assert k8s.wait_on_condition(ref, "IPAddressesHealthy", "True", wait_periods=10)
+        condition.assert_synced(ref)
+
+        cr = k8s.get_resource(ref)
+        ip_statuses = [ip["status"] for ip in cr["status"]["ipAddresses"]]
+        assert ip_statuses == ["ATTACHED"] * len(ip_statuses)
+
+    def test_auto_remediation_leaves_healthy_ips(self, route53resolver_client, resolver_endpoint):
+        (ref, cr) = resolver_endpoint
+        resolver_endpoint_id = cr["status"]["id"]
+        wait_for_operational(route53resolver_client, resolver_endpoint_id)
+
+        ip_ids = sorted(ip["IpId"] for ip in route53resolver_client.list_resolver_endpoint_ip_addresses(
+            ResolverEndpointId=resolver_endpoint_id,
+        )["IpAddresses"])
+
+        updates = {
+            "spec": {
+                "autoRemediation": True
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+        assert k8s.wait_on_condition(ref, "IPAddressesHealthy", "True", wait_periods=10)
+
+        # Nothing has failed, so nothing is remediated.
+        cr = k8s.get_resource(ref)
+        assert not cr["status"].get("ipAddressRemediations")
+        assert not cr["status"].get("remediationSteps")
+
+        aws_ip_ids = sorted(ip["IpId"] for ip in route53resolver_client.list_resolver_endpoint_ip_addresses(
+            ResolverEndpointId=resolver_endpoint_id,
+        )["IpAddresses"])
+        assert aws_ip_ids == ip_ids
+
+    def test_update_to_ipv6_is_terminal(self, resolver_endpoint):
+        (ref, cr) = resolver_endpoint
+
+        updates = {
+            "spec": {
+                "resolverEndpointType": "IPV6"
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+
+        assert k8s.wait_on_condition(ref, "ACK.Terminal", "True", wait_periods=5)
+
+    def test_migrate_to_dualstack(self, route53resolver_client, resolver_endpoint):
+        (ref, cr) = resolver_endpoint
+        resolver_endpoint_id = cr["status"]["id"]
+        wait_for_operational(route53resolver_client, resolver_endpoint_id)
+
+        subnet_ids = [
+            get_bootstrap_resources().ResolverEndpointVPC.private_subnets.subnet_ids[0],
+            get_bootstrap_resources().ResolverEndpointVPC.private_subnets.subnet_ids[1],
+        ]
+        has_ipv6 = all(get_ipv6_cidr(subnet_id) for subnet_id in subnet_ids)
+
+        updates = {
+            "spec": {
+                "resolverEndpointType": "DUALSTACK"
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+
+        if not has_ipv6:
+            # Subnets without an IPv6 CIDR block are rejected before the
+            # endpoint is touched.
+            assert k8s.wait_on_condition(ref, "ACK.Terminal", "True", wait_periods=5)
+            aws_res = route53resolver_client.get_resolver_endpoint(ResolverEndpointId=resolver_endpoint_id)
+            assert aws_res["ResolverEndpoint"]["ResolverEndpointType"] == "IPV4"
+            return
+
+        wait_for_operational(route53resolver_client, resolver_endpoint_id)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        aws_res = route53resolver_client.get_resolver_endpoint(ResolverEndpointId=resolver_endpoint_id)
+        assert aws_res["ResolverEndpoint"]["ResolverEndpointType"] == "DUALSTACK"
+
+        # The existing IP addresses are kept and gain an IPv6 address.
+        ips = route53resolver_client.list_resolver_endpoint_ip_addresses(
+            ResolverEndpointId=resolver_endpoint_id,
+        )["IpAddresses"]
+        assert len(ips) == 2
+        assert all(ip.get("Ipv6") for ip in ips)
+
+    def test_creator_request_id_is_deterministic(self, route53resolver_client, resolver_endpoint):
+        (ref, cr) = resolver_endpoint
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=30)
+
+        # The CreatorRequestId is derived from the UID and generation of the
+        # object that was created.
+        cr = k8s.get_resource(ref)
+        creator_request_id = cr["status"]["creatorRequestID"]
+        assert creator_request_id == f"{cr['metadata']['uid']}-1"
+
+        aws_res = route53resolver_client.list_resolver_endpoints(
+            Filters=[{"Name": "CreatorRequestId", "Values": [creator_request_id]}],
+        )["ResolverEndpoints"]
+        assert [e["Id"] for e in aws_res] == [cr["status"]["id"]]
+
+    def test_adopt_by_filters(self, route53resolver_client, resolver_endpoint_filter_adopt):
+        (ref, resolver_id) = resolver_endpoint_filter_adopt
+
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+        cr = k8s.get_resource(ref)
+        assert cr["status"]["id"] == resolver_id
+
+        # No second endpoint was created with the same name.
+        endpoints = route53resolver_client.list_resolver_endpoints(
+            Filters=[{"Name": "Name", "Values": [cr["spec"]["name"]]}],
+        )["ResolverEndpoints"]
+        assert [e["Id"] for e in endpoints] == [resolver_id]
+
+    def test_adopt_by_filters_without_match(self, route53resolver_client):
+        resolver_endpoint = random_suffix_name("resolver-endpoint", 32)
+
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["RESOLVER_NAME"] = resolver_endpoint
+        replacements["DIRECTION"] = "OUTBOUND"
+        replacements["SUBNET_1"] = get_bootstrap_resources().ResolverEndpointVPC.private_subnets.subnet_ids[0]
+        replacements["SUBNET_2"] = get_bootstrap_resources().ResolverEndpointVPC.private_subnets.subnet_ids[1]
+        replacements["SECURITY_GROUP"] = get_security_group(get_bootstrap_resources().ResolverEndpointVPC.vpc_id)
+
+        resource_data = load_route53resolver_resource(
+            "resolver_endpoint_filter_adoption",
+            additional_replacements=replacements,
+        )
+        ref = k8s.CustomResourceReference(
+            CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+            resolver_endpoint, namespace="default",
+        )
+        k8s.create_custom_resource(ref, resource_data)
+        k8s.wait_resource_consumed_by_controller(ref)
+        time.sleep(CHECK_STATUS_WAIT_SECONDS)
+
+        try:
+            # Nothing matches the filters, so no endpoint is created.
+            cr = k8s.get_resource(ref)
+            assert not cr["status"].get("id")
+            endpoints = route53resolver_client.list_resolver_endpoints(
+                Filters=[{"Name": "Name", "Values": [resolver_endpoint]}],
+            )["ResolverEndpoints"]
+            assert endpoints == []
+        finally:
+            k8s.delete_custom_resource(ref, 3, 10)
+
+
+def get_ipv6_cidr(subnet_id: str) -> str:
+    ec2_client = boto3.client("ec2")
+    subnet = ec2_client.describe_subnets(SubnetIds=[subnet_id])["Subnets"][0]
+    for assoc in subnet.get("Ipv6CidrBlockAssociationSet", []):
+        if assoc["Ipv6CidrBlockState"]["State"] == "associated":
+            return assoc["Ipv6CidrBlock"]
+    return ""
+
+
+def get_free_ip(subnet_id: str, offset: int) -> str:
+    ec2_client = boto3.client("ec2")
+    cidr = ec2_client.describe_subnets(SubnetIds=[subnet_id])["Subnets"][0]["CidrBlock"]
+    return str(ipaddress.ip_network(cidr)[offset])
+
+
+def wait_for_operational(route53resolver_client, resolver_endpoint_id, wait_periods=30):
+    for _ in range(wait_periods):
+        aws_res = route53resolver_client.get_resolver_endpoint(ResolverEndpointId=resolver_endpoint_id)
+        if aws_res["ResolverEndpoint"]["Status"] == "OPERATIONAL":
+            return
+        time.sleep(CHECK_STATUS_WAIT_SECONDS)
+    pytest.fail(f"Resolver Endpoint '{resolver_endpoint_id}' did not become OPERATIONAL")
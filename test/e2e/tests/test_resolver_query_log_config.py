@@ -136,3 +136,36 @@ class TestResolverQueryLogConfig:
         tag_map = {t["Key"]: t["Value"] for t in tags_res["Tags"]}
         assert tag_map.get("env") == "testing"
         assert tag_map.get("managed-by") == "ack-e2e-test"
+
+    def test_destination_ref_waits_for_destination(self, route53resolver_client):
+        config_name = random_suffix_name("qlc-ref-test", 32)
+
+        replacements = REPLACEMENT_VALUES.copy()
+        replacements["RESOLVER_QUERY_LOG_CONFIG_NAME"] = config_name
+        replacements["DESTINATION_KIND"] = "Bucket"
+        replacements["DESTINATION_NAME"] = random_suffix_name("missing-bucket", 32)
+
+        resource_data = load_route53resolver_resource(
+            "resolver_query_log_config_ref",
+            additional_replacements=replacements,
+        )
+
+        ref = k8s.CustomResourceReference(
+            CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+            config_name, namespace="default",
+        )
+        k8s.create_custom_resource(ref, resource_data)
+        try:
+            assert k8s.wait_resource_consumed_by_controller(ref) is not None
+
+            # The configuration is not created while its destination is missing.
+            assert k8s.wait_on_condition(ref, "ACK.ReferencesResolved", "Unknown", wait_periods=5)
+            cr = k8s.get_resource(ref)
+            assert "id" not in cr.get("status", {})
+
+            configs = route53resolver_client.list_resolver_query_log_configs(
+                Filters=[{"Name": "Name", "Values": [config_name]}],
+            )["ResolverQueryLogConfigs"]
+            assert configs == []
+        finally:
+            k8s.delete_custom_resource(ref, 3, 10)
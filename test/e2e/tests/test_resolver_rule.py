@@ -38,7 +38,7 @@ MODIFY_WAIT_AFTER_SECONDS = 10
 CHECK_STATUS_WAIT_SECONDS = 10
 
 
-def create_resolver_endpoint():
+def create_resolver_endpoint(protocols=None):
     resolver_endpoint = random_suffix_name("resolver-endpoint-for-rule", 32)
     security_group_id = get_security_group(get_bootstrap_resources().ResolverEndpointVPC.vpc_id)
 
@@ -54,6 +54,8 @@ def create_resolver_endpoint():
         "resolver_endpoint",
         additional_replacements=replacements,
     )
+    if protocols is not None:
+        resource_data["spec"]["protocols"] = protocols
     logging.debug(resource_data)
 
     # Create the k8s resource
@@ -123,6 +125,53 @@ def resolver_rule():
     except:
         pass
 
+@pytest.fixture
+def doh_resolver_rule():
+    resolver_rule = random_suffix_name("doh-resolver-rule", 32)
+    vpc_id = get_bootstrap_resources().ResolverEndpointVPC.vpc_id
+
+    res_end = create_resolver_endpoint(protocols=["Do53", "DoH"])
+    for i in res_end:
+        (ref_endpoint, cr_endpoint) = i
+
+    resolver_endpoint_id = cr_endpoint["status"]["id"]
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["RESOLVER_RULE_NAME"] = resolver_rule
+    replacements["RESOLVER_RULE_DOMAIN"] = "doh.xyz1"
+    replacements["RESOLVER_ENDPOINT_ID"] = resolver_endpoint_id
+    replacements["RESOLVER_RULE_TYPE"] = "FORWARD"
+    replacements["VPC_ID"] = vpc_id
+    replacements["IP"] = "1.2.3.4"
+    replacements["PORT"] = "443"
+
+    resource_data = load_route53resolver_resource(
+        "resolver_rule",
+        additional_replacements=replacements,
+    )
+    resource_data["spec"]["targetIPs"][0]["protocol"] = "DoH"
+    resource_data["spec"]["targetIPs"][0]["serverNameIndication"] = "dns.example.com"
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        resolver_rule, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    try:
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        _, deleted_endpoint = k8s.delete_custom_resource(ref_endpoint, 3, 10)
+        assert deleted
+        assert deleted_endpoint
+    except:
+        pass
+
 @service_marker
 @pytest.mark.canary
 class TestResolverRule:
@@ -198,3 +247,210 @@ class TestResolverRule:
 
         assert 'Name' in latest_resolver_rule
         assert latest_resolver_rule['Name'] == new_resolver_rule_name
+
+    def test_update_server_name_indication(self, route53resolver_client, doh_resolver_rule):
+        (ref, cr) = doh_resolver_rule
+        resolver_rule_id = cr["status"]["id"]
+
+        target_ips = route53resolver_client.get_resolver_rule(
+            ResolverRuleId=resolver_rule_id,
+        )["ResolverRule"]["TargetIps"]
+        assert target_ips[0]["Protocol"] == "DoH"
+        assert target_ips[0]["ServerNameIndication"] == "dns.example.com"
+
+        # Changing only the server name indication must update the rule.
+        target = cr["spec"]["targetIPs"][0]
+        target["serverNameIndication"] = "resolver.example.com"
+        updates = {
+            "spec": {
+                "targetIPs": [target]
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        target_ips = route53resolver_client.get_resolver_rule(
+            ResolverRuleId=resolver_rule_id,
+        )["ResolverRule"]["TargetIps"]
+        assert target_ips[0]["Protocol"] == "DoH"
+        assert target_ips[0]["ServerNameIndication"] == "resolver.example.com"
+
+    def test_association_status(self, route53resolver_client, resolver_rule):
+        (ref, cr) = resolver_rule
+        vpc_id = get_bootstrap_resources().ResolverEndpointVPC.vpc_id
+
+        for _ in range(10):
+            cr = k8s.get_resource(ref)
+            associations = cr["status"].get("vpcAssociations", [])
+            if associations and associations[0]["status"] == "COMPLETE":
+                break
+            time.sleep(CHECK_STATUS_WAIT_SECONDS)
+
+        # Every association is reported with its ID and status, not only the
+        # VPC ID kept in the spec.
+        assert len(associations) == 1
+        assert associations[0]["vpcID"] == vpc_id
+        assert associations[0]["status"] == "COMPLETE"
+
+        aws_associations = route53resolver_client.list_resolver_rule_associations(
+            Filters=[{"Name": "ResolverRuleId", "Values": [cr["status"]["id"]]}],
+        )["ResolverRuleAssociations"]
+        assert [a["Id"] for a in aws_associations] == [associations[0]["id"]]
+
+    def test_creator_request_id_is_deterministic(self, route53resolver_client, resolver_rule):
+        (ref, cr) = resolver_rule
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        # The CreatorRequestId is derived from the UID and generation of the
+        # object that was created.
+        cr = k8s.get_resource(ref)
+        creator_request_id = cr["status"]["creatorRequestID"]
+        assert creator_request_id == f"{cr['metadata']['uid']}-1"
+
+        aws_res = route53resolver_client.list_resolver_rules(
+            Filters=[{"Name": "CreatorRequestId", "Values": [creator_request_id]}],
+        )["ResolverRules"]
+        assert [r["Id"] for r in aws_res] == [cr["status"]["id"]]
+
+    def test_foreign_association_is_left_alone(self, route53resolver_client, resolver_rule):
+        (ref, cr) = resolver_rule
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+        resolver_rule_id = cr["status"]["id"]
+        vpc_id = get_bootstrap_resources().ResolverEndpointVPC.vpc_id
+        other_vpc_id = get_bootstrap_resources().AssociationTestVPC.vpc_id
+
+        # An association created under another name is owned elsewhere, for
+        # example by a ResolverRuleAssociation.
+        foreign = route53resolver_client.associate_resolver_rule(
+            ResolverRuleId=resolver_rule_id,
+            VPCId=other_vpc_id,
+            Name="team-association",
+        )["ResolverRuleAssociation"]
+        try:
+            # Trigger a sync of the inline associations.
+            k8s.patch_custom_resource(ref, {"spec": {"tags": [{"key": "k1", "value": "v2"}]}})
+            time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+            assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+            assert k8s.wait_on_condition(ref, "AssociationConflict", "False", wait_periods=5)
+
+            cr = k8s.get_resource(ref)
+            assert [a["vpcID"] for a in cr["spec"]["associations"]] == [vpc_id]
+
+            aws_associations = route53resolver_client.list_resolver_rule_associations(
+                Filters=[{"Name": "ResolverRuleId", "Values": [resolver_rule_id]}],
+            )["ResolverRuleAssociations"]
+            assert foreign["Id"] in [a["Id"] for a in aws_associations]
+            inline = [a for a in aws_associations if a["VPCId"] == vpc_id]
+            assert inline[0]["Name"] == "ack-resolver-rule-inline"
+
+            # Listing the VPC inline as well is reported as a conflict.
+            updates = {
+                "spec": {
+                    "associations": [{"vpcID": vpc_id}, {"vpcID": other_vpc_id}],
+                }
+            }
+            k8s.patch_custom_resource(ref, updates)
+            time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+            assert k8s.wait_on_condition(ref, "AssociationConflict", "True", wait_periods=5)
+
+            cr = k8s.get_resource(ref)
+            conflict = [c for c in cr["status"]["conditions"] if c["type"] == "AssociationConflict"][0]
+            assert other_vpc_id in conflict["message"]
+            assert "team-association" in conflict["message"]
+
+            # Removing the VPC from the spec again does not disassociate it.
+            k8s.patch_custom_resource(ref, {"spec": {"associations": [{"vpcID": vpc_id}]}})
+            time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+            assert k8s.wait_on_condition(ref, "AssociationConflict", "False", wait_periods=5)
+
+            association = route53resolver_client.get_resolver_rule_association(
+                ResolverRuleAssociationId=foreign["Id"],
+            )["ResolverRuleAssociation"]
+            assert association["Status"] != "DELETING"
+        finally:
+            route53resolver_client.disassociate_resolver_rule(
+                ResolverRuleId=resolver_rule_id,
+                VPCId=other_vpc_id,
+            )
+
+    def test_delete_waits_for_foreign_association(self, route53resolver_client, resolver_rule):
+        (ref, cr) = resolver_rule
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+        resolver_rule_id = cr["status"]["id"]
+        other_vpc_id = get_bootstrap_resources().AssociationTestVPC.vpc_id
+
+        route53resolver_client.associate_resolver_rule(
+            ResolverRuleId=resolver_rule_id,
+            VPCId=other_vpc_id,
+            Name="team-association",
+        )
+
+        # The inline association is disassociated, the foreign one blocks the
+        # deletion.
+        k8s.delete_custom_resource(ref, 1, 1)
+        assert k8s.wait_on_condition(ref, "DeletionProgressing", "False", wait_periods=10)
+        cr = k8s.get_resource(ref)
+        progressing = [c for c in cr["status"]["conditions"] if c["type"] == "DeletionProgressing"][0]
+        assert progressing["reason"] == "BlockedByForeignAssociations"
+        assert other_vpc_id in progressing["message"]
+
+        aws_res = route53resolver_client.get_resolver_rule(ResolverRuleId=resolver_rule_id)
+        assert aws_res is not None
+
+        # Once the owner removes its association, the rule is deleted.
+        route53resolver_client.disassociate_resolver_rule(
+            ResolverRuleId=resolver_rule_id,
+            VPCId=other_vpc_id,
+        )
+        for _ in range(20):
+            if not k8s.get_resource_exists(ref):
+                break
+            time.sleep(CHECK_STATUS_WAIT_SECONDS)
+        assert not k8s.get_resource_exists(ref)
+
+        with pytest.raises(route53resolver_client.exceptions.ResourceNotFoundException):
+            route53resolver_client.get_resolver_rule(ResolverRuleId=resolver_rule_id)
+
+    def test_move_to_another_endpoint(self, route53resolver_client, resolver_rule):
+        (ref, cr) = resolver_rule
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+        resolver_rule_id = cr["status"]["id"]
+
+        res_end = create_resolver_endpoint()
+        for i in res_end:
+            (ref_endpoint, cr_endpoint) = i
+        try:
+            assert k8s.wait_on_condition(ref_endpoint, "ACK.ResourceSynced", "True", wait_periods=30)
+            cr_endpoint = k8s.get_resource(ref_endpoint)
+            new_endpoint_id = cr_endpoint["status"]["id"]
+
+            # The rule is repointed to the new endpoint in place.
+            k8s.patch_custom_resource(ref, {"spec": {"resolverEndpointID": new_endpoint_id}})
+            time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+            assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+            aws_res = route53resolver_client.get_resolver_rule(
+                ResolverRuleId=resolver_rule_id,
+            )["ResolverRule"]
+            assert aws_res["ResolverEndpointId"] == new_endpoint_id
+            assert k8s.get_resource(ref)["status"]["id"] == resolver_rule_id
+        finally:
+            # Point the rule back so that the fixture can delete both endpoints.
+            k8s.patch_custom_resource(ref, {"spec": {"resolverEndpointID": cr["spec"]["resolverEndpointID"]}})
+            time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+            k8s.delete_custom_resource(ref_endpoint, 3, 10)
+
+    def test_update_domain_name_is_terminal(self, route53resolver_client, resolver_rule):
+        (ref, cr) = resolver_rule
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        k8s.patch_custom_resource(ref, {"spec": {"domainName": "other.xyz1"}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.Terminal", "True", wait_periods=5)
+
+        aws_res = route53resolver_client.get_resolver_rule(
+            ResolverRuleId=cr["status"]["id"],
+        )["ResolverRule"]
+        assert aws_res["DomainName"].rstrip(".") == "abc.xyz1"
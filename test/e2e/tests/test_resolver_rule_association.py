@@ -161,6 +161,58 @@ def two_vpc_associations(route53resolver_client):
     delete_rule(route53resolver_client, rule_id)
 
 
+@pytest.fixture
+def read_only_rule_association(route53resolver_client):
+    """Observe an existing rule with a read-only ResolverRule CR and associate
+    it with a VPC through resolverRuleRef."""
+    rule_id = create_system_rule(route53resolver_client)
+    vpc_id = get_bootstrap_resources().ResolverEndpointVPC.vpc_id
+
+    rule_name = random_suffix_name("read-only-rule", 32)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["RESOLVER_RULE_NAME"] = rule_name
+    replacements["ADOPTION_FIELDS"] = f"{{\\\"id\\\": \\\"{rule_id}\\\"}}"
+    rule_data = load_route53resolver_resource(
+        "resolver_rule_read_only",
+        additional_replacements=replacements,
+    )
+    logging.debug(rule_data)
+
+    rule_ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, "resolverrules",
+        rule_name, namespace="default",
+    )
+    k8s.create_custom_resource(rule_ref, rule_data)
+    assert k8s.wait_resource_consumed_by_controller(rule_ref) is not None
+
+    association_name = random_suffix_name("rule-assoc-ref", 32)
+    replacements["RESOLVER_RULE_ASSOCIATION_NAME"] = association_name
+    replacements["VPC_ID"] = vpc_id
+    association_data = load_route53resolver_resource(
+        "resolver_rule_association_ref",
+        additional_replacements=replacements,
+    )
+    logging.debug(association_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        association_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, association_data)
+    assert k8s.wait_resource_consumed_by_controller(ref) is not None
+
+    yield (rule_ref, ref, rule_id)
+
+    for cleanup_ref in (ref, rule_ref):
+        try:
+            if k8s.get_resource_exists(cleanup_ref):
+                k8s.delete_custom_resource(cleanup_ref, 3, 10)
+        except Exception as e:
+            logging.warning(f"Cleanup failed for {cleanup_ref.name}: {e}")
+
+    delete_rule(route53resolver_client, rule_id)
+
+
 @service_marker
 class TestResolverRuleAssociation:
     @pytest.mark.canary
@@ -231,3 +283,34 @@ class TestResolverRuleAssociation:
         cr_2 = k8s.get_resource(refs[1][0])
         assert cr_1["status"]["id"] != cr_2["status"]["id"]
         assert cr_1["spec"]["vpcID"] != cr_2["spec"]["vpcID"]
+
+    def test_associate_read_only_rule(self, route53resolver_client, read_only_rule_association):
+        """Test that a read-only ResolverRule reports the rule and can be
+        referenced, but is never updated or deleted."""
+        (rule_ref, ref, rule_id) = read_only_rule_association
+
+        assert k8s.wait_on_condition(rule_ref, "ACK.ResourceSynced", "True", wait_periods=10)
+        rule_cr = k8s.get_resource(rule_ref)
+        assert rule_cr["status"]["id"] == rule_id
+        assert rule_cr["status"]["ownerID"]
+        assert rule_cr["status"]["shareStatus"]
+
+        # The association resolves the rule ID from the read-only rule.
+        cr = wait_for_association_complete(ref)
+        condition.assert_synced(ref)
+        assert cr["spec"]["resolverRuleID"] == rule_id
+
+        # A change to the spec of a read-only rule is not applied.
+        name = rule_cr["spec"]["name"]
+        k8s.patch_custom_resource(rule_ref, {"spec": {"name": "renamed-by-test"}})
+        time.sleep(10)
+        aws_rule = route53resolver_client.get_resolver_rule(ResolverRuleId=rule_id)["ResolverRule"]
+        assert aws_rule["Name"] == name
+
+        # Deleting the association and the read-only rule leaves the rule in AWS.
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+        _, deleted = k8s.delete_custom_resource(rule_ref, 3, 10)
+        assert deleted
+        aws_rule = route53resolver_client.get_resolver_rule(ResolverRuleId=rule_id)["ResolverRule"]
+        assert aws_rule["Id"] == rule_id
@@ -0,0 +1,150 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the Route53 Resolver ResolverRulePolicy resource
+"""
+
+import json
+import logging
+import time
+
+import pytest
+
+from acktest.aws.identity import get_account_id
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_route53resolver_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+
+RESOURCE_PLURAL = "resolverrulepolicies"
+
+# Time to wait after modifying the CR for the status to change
+MODIFY_WAIT_AFTER_SECONDS = 10
+
+# Time to wait for the controller to detect and revert drift
+DRIFT_WAIT_AFTER_SECONDS = 60
+
+
+def get_policy(route53resolver_client, rule_arn: str) -> dict:
+    policy = route53resolver_client.get_resolver_rule_policy(Arn=rule_arn)["ResolverRulePolicy"]
+    return json.loads(policy)
+
+
+def as_list(value):
+    return value if isinstance(value, list) else [value]
+
+
+@pytest.fixture
+def resolver_rule_policy(route53resolver_client):
+    rule_name = random_suffix_name("policy-test-rule", 32)
+    rule = route53resolver_client.create_resolver_rule(
+        CreatorRequestId=rule_name,
+        DomainName=f"{rule_name}.example.com",
+        Name=rule_name,
+        RuleType="SYSTEM",
+    )["ResolverRule"]
+
+    policy_name = random_suffix_name("resolver-rule-policy", 32)
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["RESOLVER_RULE_POLICY_NAME"] = policy_name
+    replacements["RESOLVER_RULE_ARN"] = rule["Arn"]
+    replacements["PRINCIPAL_ACCOUNT_ID"] = get_account_id()
+
+    resource_data = load_route53resolver_resource(
+        "resolver_rule_policy",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        policy_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr, rule)
+
+    try:
+        if k8s.get_resource_exists(ref):
+            k8s.delete_custom_resource(ref, 3, 10)
+    except Exception as e:
+        logging.warning(f"Cleanup failed for {policy_name}: {e}")
+    route53resolver_client.delete_resolver_rule(ResolverRuleId=rule["Id"])
+
+
+@service_marker
+@pytest.mark.canary
+class TestResolverRulePolicy:
+    def test_create_update_delete(self, route53resolver_client, resolver_rule_policy):
+        (ref, cr, rule) = resolver_rule_policy
+        account_id = get_account_id()
+
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+        cr = k8s.get_resource(ref)
+        assert cr["status"]["resolverRulePolicy"]
+
+        # The policy is built from the principals and actions in the spec.
+        statement = get_policy(route53resolver_client, rule["Arn"])["Statement"][0]
+        assert any(account_id in p for p in as_list(statement["Principal"]["AWS"]))
+        assert sorted(as_list(statement["Action"])) == [
+            "route53resolver:AssociateResolverRule",
+            "route53resolver:GetResolverRule",
+        ]
+        assert as_list(statement["Resource"]) == [rule["Arn"]]
+
+        # Leaving actions empty grants every action a rule policy allows.
+        k8s.patch_custom_resource(ref, {"spec": {"actions": None}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        statement = get_policy(route53resolver_client, rule["Arn"])["Statement"][0]
+        assert len(as_list(statement["Action"])) == 5
+
+        # Deleting the CR leaves a policy that only grants the owner account.
+        _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+        assert deleted
+
+        statement = get_policy(route53resolver_client, rule["Arn"])["Statement"][0]
+        assert all(rule["OwnerId"] in p for p in as_list(statement["Principal"]["AWS"]))
+
+    def test_drift_is_reverted(self, route53resolver_client, resolver_rule_policy):
+        (ref, cr, rule) = resolver_rule_policy
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        # Dropping an action outside the controller is a difference.
+        policy = get_policy(route53resolver_client, rule["Arn"])
+        policy["Statement"][0]["Action"] = "route53resolver:GetResolverRule"
+        route53resolver_client.put_resolver_rule_policy(
+            Arn=rule["Arn"],
+            ResolverRulePolicy=json.dumps(policy),
+        )
+        time.sleep(DRIFT_WAIT_AFTER_SECONDS)
+
+        statement = get_policy(route53resolver_client, rule["Arn"])["Statement"][0]
+        assert sorted(as_list(statement["Action"])) == [
+            "route53resolver:AssociateResolverRule",
+            "route53resolver:GetResolverRule",
+        ]
+
+    def test_invalid_principal_is_terminal(self, route53resolver_client, resolver_rule_policy):
+        (ref, cr, rule) = resolver_rule_policy
+        assert k8s.wait_on_condition(ref, "ACK.ResourceSynced", "True", wait_periods=10)
+
+        k8s.patch_custom_resource(ref, {"spec": {"principals": ["not-an-account"]}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        assert k8s.wait_on_condition(ref, "ACK.Terminal", "True", wait_periods=5)